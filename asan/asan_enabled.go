@@ -0,0 +1,9 @@
+// SPDX-FileCopyrightText: Copyright 2023 Prasad Tengse
+// SPDX-License-Identifier: MIT
+
+//go:build asan
+
+package asan
+
+// Enabled reports if the address sanitizer is enabled.
+const Enabled = true
@@ -0,0 +1,85 @@
+package assert
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/tprasadtp/pkg/race"
+)
+
+// Number of times function is run to measure allocations.
+const allocRuns = 100
+
+// MaxAllocs asserts that f allocates at most n times per run on average.
+// This uses [testing.AllocsPerRun]. As race detector and sanitizers add
+// allocations of their own, and disabling optimizations disables escape
+// analysis and inlining, assertion is not checked for such builds.
+// Instead, a message is logged and true is returned. Rest of the test
+// still runs.
+//
+//	assert.MaxAllocs(t, 0, func() {
+//		buf = v.AppendString(buf[:0])
+//	})
+func MaxAllocs(t testing.TB, n int, f func(), args ...any) bool {
+	t.Helper()
+	if instrumentedAllocs(t) {
+		return true
+	}
+	allocs := testing.AllocsPerRun(allocRuns, f)
+	if allocs <= float64(n) {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected at most %d allocation(s) per run, got %.0f", n, allocs)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// MaxBytesPerOp asserts that f allocates at most n bytes per run on average.
+// Like [testing.AllocsPerRun], GOMAXPROCS is set to 1 and f is run once
+// before measuring, to reduce noise from other goroutines and to exclude
+// one-time allocations.
+// Like [MaxAllocs], assertion is not checked for instrumented builds.
+func MaxBytesPerOp(t testing.TB, n uint64, f func(), args ...any) bool {
+	t.Helper()
+	if instrumentedAllocs(t) {
+		return true
+	}
+	bytes := bytesPerRun(allocRuns, f)
+	if bytes <= n {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected at most %d byte(s) allocated per run, got %d", n, bytes)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// bytesPerRun returns the average number of bytes allocated during a call to f.
+// Like [testing.AllocsPerRun], it runs f once as a warm-up and sets GOMAXPROCS
+// to 1 during measurement.
+func bytesPerRun(runs int, f func()) uint64 {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+
+	// Warm up the function.
+	f()
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for i := 0; i < runs; i++ {
+		f()
+	}
+	runtime.ReadMemStats(&after)
+	return (after.TotalAlloc - before.TotalAlloc) / uint64(runs)
+}
+
+// instrumentedAllocs returns true and logs a message if allocations cannot
+// be measured reliably, as the binary was built with instrumentation.
+func instrumentedAllocs(t testing.TB) bool {
+	t.Helper()
+	i := race.Instrumented()
+	if i.Race || i.ASan || i.MSan || i.OptimizationsDisabled {
+		t.Logf("%s => skipping allocation assertion in instrumented build(%s)", t.Name(), i)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tprasadtp/pkg/assert/asserttest"
+	"github.com/tprasadtp/pkg/race"
+)
+
+//nolint:gochecknoglobals // prevents compiler from optimizing allocations away.
+var allocSink []byte
+
+func TestMaxAllocs(t *testing.T) {
+	MaxAllocs(t, 0, func() {})
+	MaxAllocs(t, 1, func() {
+		allocSink = make([]byte, 64)
+	})
+	MaxBytesPerOp(t, 128, func() {
+		allocSink = make([]byte, 64)
+	})
+}
+
+func TestMaxAllocs_Instrumented(t *testing.T) {
+	i := race.Instrumented()
+	if !(i.Race || i.ASan || i.MSan || i.OptimizationsDisabled) {
+		t.Skipf("%s => not an instrumented build", t.Name())
+	}
+	r := asserttest.NewRecorder(t)
+	var reached bool
+	ok := r.Run(func(tb testing.TB) {
+		MaxAllocs(tb, 0, func() {
+			allocSink = make([]byte, 64)
+		})
+		MaxBytesPerOp(tb, 32, func() {
+			allocSink = make([]byte, 1024)
+		})
+		reached = true
+	})
+	if !ok || r.Skipped() || !reached {
+		t.Errorf("instrumented build must only skip assertion")
+	}
+	if logs := r.Messages(asserttest.KindLog); len(logs) != 2 {
+		t.Errorf("expected 2 log messages, got: %q", logs)
+	}
+}
+
+func TestMaxAllocs_FailureMessage(t *testing.T) {
+	if instrumentedAllocs(t) {
+		t.SkipNow()
+	}
+	r := asserttest.NewRecorder(t)
+	r.Run(func(tb testing.TB) {
+		MaxAllocs(tb, 0, func() {
+			allocSink = make([]byte, 64)
+		})
+		MaxBytesPerOp(tb, 32, func() {
+			allocSink = make([]byte, 1024)
+		})
+	})
+	msgs := r.Messages()
+	if len(msgs) != 2 {
+		t.Fatalf("expected 2 failures, got: %q", msgs)
+	}
+	if !strings.HasPrefix(msgs[0], "Expected at most 0 allocation(s) per run, got 1") {
+		t.Errorf("unexpected message: %s", msgs[0])
+	}
+	if !strings.HasPrefix(msgs[1], "Expected at most 32 byte(s) allocated per run, got 1024") {
+		t.Errorf("unexpected message: %s", msgs[1])
+	}
+}
@@ -13,7 +13,7 @@ func msgf(fallback string, args ...any) string {
 	}
 
 	if s, ok := (args[0]).(string); ok {
-		return fmt.Sprintf(s, args[1:])
+		return fmt.Sprintf(s, args[1:]...)
 	}
 	return fallback
 }
@@ -24,87 +24,92 @@ func msgf(fallback string, args ...any) string {
 //
 //	parser := func(){panic("this function panics")}
 //	assert.Panics(t, "%s => parser function should panic, it did not", t.Name())
-func Panics(t *testing.T, f func(), args ...any) {
+func Panics(t testing.TB, f func(), args ...any) bool {
 	t.Helper()
-	defer func() {
-		if recover() == nil {
-			t.Errorf(msgf("Expected panic, but did not", args...))
-		}
-	}()
-	f()
+	if RecoverPanic(f) != nil {
+		return true
+	}
+	t.Error(msgf("Expected panic, but did not", args...))
+	return false
 }
 
 // NotPanics asserts that the given function does not panic.
-func NotPanics(t *testing.T, f func(), args ...any) {
+func NotPanics(t testing.TB, f func(), args ...any) bool {
 	t.Helper()
-	defer func() {
-		if err := recover(); err != nil {
-			fallback := fmt.Sprintf("Expcted not to panic: %v", err)
-			t.Errorf(msgf(fallback, args...))
-		}
-	}()
-	f()
+	p := RecoverPanic(f)
+	if p == nil {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected not to panic: %v\n%s", p.Value, p.Stack)
+	t.Error(msgf(fallback, args...))
+	return false
 }
 
 // IsError asserts than any error in "err"'s tree matches "target".
 //
 //	_, err := someFunction()
 //	assert.IsError(t, err, ExpectedErr)
-func IsError(t testing.TB, err, target error, args ...any) {
+func IsError(t testing.TB, err, target error, args ...any) bool {
 	t.Helper()
 	if errors.Is(err, target) {
-		return
+		return true
 	}
-	fallback := fmt.Sprintf("Error tree %q should contain error %q", err, target)
+	fallback := fmt.Sprintf("Error tree should contain error %q:\n%s", target, formatErrorTree(err))
 	t.Error(msgf(fallback, args...))
+	return false
 }
 
-// IsError asserts than any error in "err"'s tree matches "target".
+// NotIsError asserts than none of the errors in "err"'s tree match "target".
 //
 //	_, err := someFunction()
 //	assert.NotIsError(t, err, NotExpectedErr)
-func NotIsError(t testing.TB, err, target error, args ...any) {
+func NotIsError(t testing.TB, err, target error, args ...any) bool {
 	t.Helper()
 	if !errors.Is(err, target) {
-		return
+		return true
 	}
-	fallback := fmt.Sprintf("Error tree %q should NOT contain error %q", err, target)
+	fallback := fmt.Sprintf("Error tree should NOT contain error %q:\n%s", target, formatErrorTree(err))
 	t.Error(msgf(fallback, args...))
+	return false
 }
 
 // Errors asserts that an error is not nil.
-func Errors(t testing.TB, err error, args ...any) {
+func Errors(t testing.TB, err error, args ...any) bool {
 	t.Helper()
 	if err != nil {
-		return
+		return true
 	}
-	t.Errorf(msgf("Expected an error, but got nil", args...))
+	t.Error(msgf("Expected an error, but got nil", args...))
+	return false
 }
 
 // NoErrors asserts that an error is nil.
-func NoErrors(t testing.TB, err error, args ...any) {
+func NoErrors(t testing.TB, err error, args ...any) bool {
 	if err == nil {
-		return
+		return true
 	}
 	t.Helper()
-	fallback := fmt.Sprintf("Expected no error, but got: %s", err)
-	t.Errorf(msgf(fallback, args...))
+	fallback := fmt.Sprintf("Expected no error, but got:\n%s", formatErrorTree(err))
+	t.Error(msgf(fallback, args...))
+	return false
 }
 
 // True asserts that an expression is true.
-func True(t testing.TB, ok bool, args ...any) {
+func True(t testing.TB, ok bool, args ...any) bool {
 	if ok {
-		return
+		return true
 	}
 	t.Helper()
-	t.Fatal(msgf("Expected expression to be true", args...))
+	t.Error(msgf("Expected expression to be true", args...))
+	return false
 }
 
 // False asserts that an expression is false.
-func False(t testing.TB, ok bool, args ...any) {
+func False(t testing.TB, ok bool, args ...any) bool {
 	if !ok {
-		return
+		return true
 	}
 	t.Helper()
-	t.Fatal(msgf("Expected expression to be false", args...))
+	t.Error(msgf("Expected expression to be false", args...))
+	return false
 }
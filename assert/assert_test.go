@@ -0,0 +1,114 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tprasadtp/pkg/assert/asserttest"
+)
+
+// failureMessages runs f with a recorder and returns recorded failures.
+func failureMessages(t *testing.T, f func(tb testing.TB)) []string {
+	t.Helper()
+	r := asserttest.NewRecorder(t)
+	r.Run(f)
+	if r.HelperCalls() == 0 {
+		t.Errorf("assertion must call t.Helper()")
+	}
+	return r.Messages()
+}
+
+func TestAssertions_FailureMessages(t *testing.T) {
+	errTest := errors.New("test error")
+	type testCase struct {
+		name   string
+		f      func(tb testing.TB)
+		expect string
+	}
+	tt := []testCase{
+		{
+			name:   "True",
+			f:      func(tb testing.TB) { True(tb, false) },
+			expect: "Expected expression to be true",
+		},
+		{
+			name:   "False",
+			f:      func(tb testing.TB) { False(tb, true) },
+			expect: "Expected expression to be false",
+		},
+		{
+			name:   "CustomMessage",
+			f:      func(tb testing.TB) { True(tb, false, "%s => %d", "custom", 1) },
+			expect: "custom => 1",
+		},
+		{
+			name:   "Errors",
+			f:      func(tb testing.TB) { Errors(tb, nil) },
+			expect: "Expected an error, but got nil",
+		},
+		{
+			name:   "NoErrors",
+			f:      func(tb testing.TB) { NoErrors(tb, errTest) },
+			expect: "Expected no error, but got:\n*errors.errorString: \"test error\"",
+		},
+		{
+			name: "IsError",
+			f: func(tb testing.TB) {
+				IsError(tb, fmt.Errorf("wrapped: %w", errTest), errors.New("other"))
+			},
+			expect: "Error tree should contain error \"other\":\n" +
+				"*fmt.wrapError: \"wrapped: test error\"\n" +
+				"  *errors.errorString: \"test error\"",
+		},
+		{
+			name:   "NotIsError",
+			f:      func(tb testing.TB) { NotIsError(tb, errTest, errTest) },
+			expect: "Error tree should NOT contain error \"test error\":\n*errors.errorString: \"test error\"",
+		},
+		{
+			name:   "Panics",
+			f:      func(tb testing.TB) { Panics(tb, func() {}) },
+			expect: "Expected panic, but did not",
+		},
+		{
+			name:   "Equal",
+			f:      func(tb testing.TB) { Equal(tb, []int{1, 2}, []int{1, 3}) },
+			expect: "Values are not equal:\n  [1]: expected 2, got 3\n",
+		},
+		{
+			name:   "NotEqual",
+			f:      func(tb testing.TB) { NotEqual(tb, "foo", "foo") },
+			expect: `Expected values to differ, but both are: "foo"`,
+		},
+		{
+			name:   "JSONEq",
+			f:      func(tb testing.TB) { JSONEq(tb, `{"a": 1}`, `{"a": 2}`) },
+			expect: "JSON documents are not equal:\n  $.a: expected 1, got 2\n",
+		},
+		{
+			name:   "ErrorContains",
+			f:      func(tb testing.TB) { ErrorContains(tb, nil, "foo") },
+			expect: "Error message should contain \"foo\":\n<nil>",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs := failureMessages(t, tc.f)
+			if len(msgs) != 1 {
+				t.Fatalf("expected 1 failure, got %d: %q", len(msgs), msgs)
+			}
+			Equal(t, tc.expect, msgs[0])
+		})
+	}
+}
+
+func TestNotPanics_FailureMessage(t *testing.T) {
+	msgs := failureMessages(t, func(tb testing.TB) {
+		NotPanics(tb, func() { panic("boom") })
+	})
+	if len(msgs) != 1 || !strings.HasPrefix(msgs[0], "Expected not to panic: boom\n") {
+		t.Errorf("unexpected messages: %q", msgs)
+	}
+}
@@ -0,0 +1,270 @@
+// Package asserttest provides utilities for testing assertion helpers.
+//
+// Assertion helpers built on top of [github.com/tprasadtp/pkg/assert]
+// can be tested by passing a [Recorder] instead of the real [testing.T],
+// and inspecting recorded failures and messages.
+//
+//	r := asserttest.NewRecorder(t)
+//	r.Run(func(tb testing.TB) {
+//		assertValidGUID(tb, "foo")
+//	})
+//	if !r.Failed() {
+//		t.Errorf("expected assertValidGUID to fail")
+//	}
+package asserttest
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Kind is kind of the recorded call.
+type Kind int
+
+const (
+	// KindLog is recorded by Log and Logf.
+	KindLog Kind = iota
+	// KindError is recorded by Error and Errorf.
+	KindError
+	// KindFatal is recorded by Fatal and Fatalf.
+	KindFatal
+	// KindSkip is recorded by Skip and Skipf.
+	KindSkip
+	// KindPanic is recorded when function passed to [Recorder.Run] panics.
+	KindPanic
+)
+
+// String returns name of the kind.
+func (k Kind) String() string {
+	switch k {
+	case KindLog:
+		return "Log"
+	case KindError:
+		return "Error"
+	case KindFatal:
+		return "Fatal"
+	case KindSkip:
+		return "Skip"
+	case KindPanic:
+		return "Panic"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Entry is a single recorded call.
+type Entry struct {
+	Kind    Kind
+	Message string
+}
+
+var _ testing.TB = (*Recorder)(nil)
+
+// Recorder implements [testing.TB] and records failures, logs and skips
+// instead of reporting them. Like the real [testing.T], Fatal, FailNow
+// and Skip stop the calling goroutine with [runtime.Goexit], thus
+// code under test must be run with [Recorder.Run].
+//
+// Methods which are not recorded, like TempDir and Setenv, are
+// delegated to the parent [testing.TB].
+type Recorder struct {
+	// Parent test. This also ensures that unexported methods
+	// of testing.TB are implemented.
+	testing.TB
+
+	mu       sync.Mutex
+	failed   bool
+	skipped  bool
+	helpers  int
+	entries  []Entry
+	cleanups []func()
+}
+
+// NewRecorder returns a new [Recorder] with parent test t.
+func NewRecorder(t testing.TB) *Recorder {
+	return &Recorder{TB: t}
+}
+
+// Run runs f in a new goroutine with recorder and waits for it to
+// complete, including functions registered with Cleanup.
+// If f panics, panic is recovered and recorded as [KindPanic],
+// and recorder is marked as failed. It returns true if f did not fail.
+func (r *Recorder) Run(f func(tb testing.TB)) bool {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer r.runCleanups()
+		defer func() {
+			// recover returns nil for runtime.Goexit used by FailNow and SkipNow.
+			if v := recover(); v != nil {
+				r.record(KindPanic, fmt.Sprintf("panic: %v", v))
+			}
+		}()
+		f(r)
+	}()
+	<-done
+	return !r.Failed()
+}
+
+func (r *Recorder) runCleanups() {
+	for {
+		r.mu.Lock()
+		if len(r.cleanups) == 0 {
+			r.mu.Unlock()
+			return
+		}
+		fn := r.cleanups[len(r.cleanups)-1]
+		r.cleanups = r.cleanups[:len(r.cleanups)-1]
+		r.mu.Unlock()
+		fn()
+	}
+}
+
+func (r *Recorder) record(kind Kind, msg string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, Entry{Kind: kind, Message: msg})
+	switch kind {
+	case KindError, KindFatal, KindPanic:
+		r.failed = true
+	case KindSkip:
+		r.skipped = true
+	default:
+	}
+}
+
+// sprintln formats args like testing package does, without trailing newline.
+func sprintln(args ...any) string {
+	return strings.TrimSuffix(fmt.Sprintln(args...), "\n")
+}
+
+// Cleanup registers a function to be called when Run completes.
+func (r *Recorder) Cleanup(f func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cleanups = append(r.cleanups, f)
+}
+
+// Error records an error and marks recorder as failed.
+func (r *Recorder) Error(args ...any) {
+	r.record(KindError, sprintln(args...))
+}
+
+// Errorf records an error and marks recorder as failed.
+func (r *Recorder) Errorf(format string, args ...any) {
+	r.record(KindError, fmt.Sprintf(format, args...))
+}
+
+// Fail marks recorder as failed.
+func (r *Recorder) Fail() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failed = true
+}
+
+// FailNow marks recorder as failed and stops the calling goroutine.
+func (r *Recorder) FailNow() {
+	r.Fail()
+	runtime.Goexit()
+}
+
+// Failed reports whether recorder has failed.
+func (r *Recorder) Failed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failed
+}
+
+// Fatal records a fatal error and stops the calling goroutine.
+func (r *Recorder) Fatal(args ...any) {
+	r.record(KindFatal, sprintln(args...))
+	runtime.Goexit()
+}
+
+// Fatalf records a fatal error and stops the calling goroutine.
+func (r *Recorder) Fatalf(format string, args ...any) {
+	r.record(KindFatal, fmt.Sprintf(format, args...))
+	runtime.Goexit()
+}
+
+// Helper records that Helper was called.
+func (r *Recorder) Helper() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.helpers++
+}
+
+// Log records a log message.
+func (r *Recorder) Log(args ...any) {
+	r.record(KindLog, sprintln(args...))
+}
+
+// Logf records a log message.
+func (r *Recorder) Logf(format string, args ...any) {
+	r.record(KindLog, fmt.Sprintf(format, args...))
+}
+
+// Skip records a skip message and stops the calling goroutine.
+func (r *Recorder) Skip(args ...any) {
+	r.record(KindSkip, sprintln(args...))
+	runtime.Goexit()
+}
+
+// Skipf records a skip message and stops the calling goroutine.
+func (r *Recorder) Skipf(format string, args ...any) {
+	r.record(KindSkip, fmt.Sprintf(format, args...))
+	runtime.Goexit()
+}
+
+// SkipNow marks recorder as skipped and stops the calling goroutine.
+func (r *Recorder) SkipNow() {
+	r.mu.Lock()
+	r.skipped = true
+	r.mu.Unlock()
+	runtime.Goexit()
+}
+
+// Skipped reports whether recorder was skipped.
+func (r *Recorder) Skipped() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.skipped
+}
+
+// HelperCalls returns number of times Helper was called.
+func (r *Recorder) HelperCalls() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.helpers
+}
+
+// Entries returns all recorded calls in order.
+func (r *Recorder) Entries() []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rv := make([]Entry, len(r.entries))
+	copy(rv, r.entries)
+	return rv
+}
+
+// Messages returns messages of recorded calls of given kinds in order.
+// If no kinds are specified, messages of [KindError], [KindFatal]
+// and [KindPanic] calls are returned.
+func (r *Recorder) Messages(kinds ...Kind) []string {
+	if len(kinds) == 0 {
+		kinds = []Kind{KindError, KindFatal, KindPanic}
+	}
+	var rv []string
+	for _, e := range r.Entries() {
+		for _, k := range kinds {
+			if e.Kind == k {
+				rv = append(rv, e.Message)
+				break
+			}
+		}
+	}
+	return rv
+}
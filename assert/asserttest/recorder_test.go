@@ -0,0 +1,91 @@
+package asserttest_test
+
+import (
+	"testing"
+
+	"github.com/tprasadtp/pkg/assert/asserttest"
+)
+
+func TestRecorder(t *testing.T) {
+	t.Run("Pass", func(t *testing.T) {
+		r := asserttest.NewRecorder(t)
+		ok := r.Run(func(tb testing.TB) {
+			tb.Helper()
+			tb.Logf("log %d", 1)
+		})
+		if !ok || r.Failed() {
+			t.Errorf("recorder must not fail")
+		}
+		if r.HelperCalls() != 1 {
+			t.Errorf("expected 1 Helper call, got %d", r.HelperCalls())
+		}
+		if msgs := r.Messages(asserttest.KindLog); len(msgs) != 1 || msgs[0] != "log 1" {
+			t.Errorf("unexpected log messages: %q", msgs)
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		r := asserttest.NewRecorder(t)
+		var reached bool
+		ok := r.Run(func(tb testing.TB) {
+			tb.Error("foo", 1)
+			tb.Errorf("bar %d", 2)
+			reached = true
+		})
+		if ok || !r.Failed() {
+			t.Errorf("recorder must fail")
+		}
+		if !reached {
+			t.Errorf("Error must not stop the goroutine")
+		}
+		msgs := r.Messages()
+		if len(msgs) != 2 || msgs[0] != "foo 1" || msgs[1] != "bar 2" {
+			t.Errorf("unexpected messages: %q", msgs)
+		}
+	})
+	t.Run("Fatal", func(t *testing.T) {
+		r := asserttest.NewRecorder(t)
+		var reached, cleanup bool
+		r.Run(func(tb testing.TB) {
+			tb.Cleanup(func() { cleanup = true })
+			tb.Fatalf("fatal %s", "error")
+			reached = true
+		})
+		if reached {
+			t.Errorf("Fatal must stop the goroutine")
+		}
+		if !cleanup {
+			t.Errorf("cleanup functions must run")
+		}
+		entries := r.Entries()
+		if len(entries) != 1 || entries[0].Kind != asserttest.KindFatal || entries[0].Message != "fatal error" {
+			t.Errorf("unexpected entries: %v", entries)
+		}
+	})
+	t.Run("Skip", func(t *testing.T) {
+		r := asserttest.NewRecorder(t)
+		ok := r.Run(func(tb testing.TB) {
+			tb.Skip("skipped")
+		})
+		if !ok || !r.Skipped() || r.Failed() {
+			t.Errorf("recorder must be skipped, but not failed")
+		}
+	})
+	t.Run("Panic", func(t *testing.T) {
+		r := asserttest.NewRecorder(t)
+		var cleanup bool
+		ok := r.Run(func(tb testing.TB) {
+			tb.Cleanup(func() { cleanup = true })
+			panic("boom")
+		})
+		if ok || !r.Failed() {
+			t.Errorf("recorder must fail")
+		}
+		if !cleanup {
+			t.Errorf("cleanup functions must run")
+		}
+		entries := r.Entries()
+		if len(entries) != 1 || entries[0].Kind != asserttest.KindPanic || entries[0].Message != "panic: boom" {
+			t.Errorf("unexpected entries: %v", entries)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tprasadtp/pkg/race"
+)
+
+// Receives asserts that a value is received from ch within timeout
+// and returns it. If channel is closed or timeout expires before a value
+// is received, zero value of T is returned. Timeout is scaled with
+// [race.ScaleDuration].
+//
+//	v := assert.Receives(t, events, time.Second)
+func Receives[T any](t testing.TB, ch <-chan T, timeout time.Duration, args ...any) T {
+	t.Helper()
+	start := time.Now()
+	timer := time.NewTimer(race.ScaleDuration(timeout))
+	defer timer.Stop()
+
+	var fallback string
+	select {
+	case v, ok := <-ch:
+		if ok {
+			return v
+		}
+		fallback = fmt.Sprintf("Channel was closed after %s, expected a value", since(start))
+	case <-timer.C:
+		fallback = fmt.Sprintf("No value received within %s", since(start))
+	}
+	t.Error(msgf(fallback, args...))
+	var zero T
+	return zero
+}
+
+// NotReceives asserts that no value is received from ch for duration.
+// Channel being closed is considered a failure, use [Closed] to
+// assert that a channel is closed. Duration is scaled with
+// [race.ScaleDuration].
+func NotReceives[T any](t testing.TB, ch <-chan T, duration time.Duration, args ...any) bool {
+	t.Helper()
+	start := time.Now()
+	timer := time.NewTimer(race.ScaleDuration(duration))
+	defer timer.Stop()
+
+	var fallback string
+	select {
+	case v, ok := <-ch:
+		if ok {
+			fallback = fmt.Sprintf("Expected no value, but received %s after %s",
+				formatValue(reflect.ValueOf(v)), since(start))
+		} else {
+			fallback = fmt.Sprintf("Expected no value, but channel was closed after %s", since(start))
+		}
+	case <-timer.C:
+		return true
+	}
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// Closed asserts that ch is closed within timeout. Receiving a value
+// from ch before it is closed is considered a failure. Timeout is scaled
+// with [race.ScaleDuration].
+func Closed[T any](t testing.TB, ch <-chan T, timeout time.Duration, args ...any) bool {
+	t.Helper()
+	start := time.Now()
+	timer := time.NewTimer(race.ScaleDuration(timeout))
+	defer timer.Stop()
+
+	var fallback string
+	select {
+	case v, ok := <-ch:
+		if !ok {
+			return true
+		}
+		fallback = fmt.Sprintf("Expected channel to be closed, but received %s after %s",
+			formatValue(reflect.ValueOf(v)), since(start))
+	case <-timer.C:
+		fallback = fmt.Sprintf("Channel was not closed within %s", since(start))
+	}
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// ReceivesAll asserts that n values are received from ch within timeout
+// and returns them in order they were received. On failure, values received
+// so far are returned. Timeout is scaled with [race.ScaleDuration].
+//
+//	events := assert.ReceivesAll(t, ch, 3, time.Second)
+func ReceivesAll[T any](t testing.TB, ch <-chan T, n int, timeout time.Duration, args ...any) []T {
+	t.Helper()
+	if n < 0 {
+		t.Errorf("Invalid count %d, must not be negative", n)
+		return nil
+	}
+	start := time.Now()
+	timer := time.NewTimer(race.ScaleDuration(timeout))
+	defer timer.Stop()
+
+	values := make([]T, 0, n)
+	var fallback string
+loop:
+	for len(values) < n {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				fallback = fmt.Sprintf("Channel was closed after %s, received %d of %d values: %s",
+					since(start), len(values), n, formatValue(reflect.ValueOf(values)))
+				break loop
+			}
+			values = append(values, v)
+		case <-timer.C:
+			fallback = fmt.Sprintf("Received %d of %d values within %s: %s",
+				len(values), n, since(start), formatValue(reflect.ValueOf(values)))
+			break loop
+		}
+	}
+	if len(values) == n {
+		return values
+	}
+	t.Error(msgf(fallback, args...))
+	return values
+}
+
+// since returns time elapsed since start, rounded for failure messages.
+func since(start time.Time) time.Duration {
+	return time.Since(start).Round(time.Millisecond)
+}
@@ -0,0 +1,110 @@
+package assert
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestChan(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	Equal(t, 1, Receives(t, ch, time.Second))
+
+	go func() {
+		for i := 0; i < 3; i++ {
+			ch <- i
+		}
+		close(ch)
+	}()
+	Equal(t, []int{0, 1, 2}, ReceivesAll(t, ch, 3, time.Second))
+	Closed(t, ch, time.Second)
+
+	NotReceives(t, make(chan string), 10*time.Millisecond)
+	Equal(t, 0, len(ReceivesAll(t, ch, 0, time.Second)))
+}
+
+func TestChan_FailureMessages(t *testing.T) {
+	closed := make(chan int)
+	close(closed)
+
+	type testCase struct {
+		name   string
+		f      func(tb testing.TB)
+		expect string
+	}
+	tt := []testCase{
+		{
+			name:   "ReceivesTimeout",
+			f:      func(tb testing.TB) { Receives(tb, make(chan int), time.Millisecond) },
+			expect: `^No value received within \d+ms$`,
+		},
+		{
+			name:   "ReceivesClosed",
+			f:      func(tb testing.TB) { Receives(tb, closed, time.Second) },
+			expect: `^Channel was closed after \d+s?, expected a value$`,
+		},
+		{
+			name: "NotReceives",
+			f: func(tb testing.TB) {
+				ch := make(chan string, 1)
+				ch <- "foo"
+				NotReceives(tb, ch, time.Second)
+			},
+			expect: `^Expected no value, but received "foo" after \d+s?$`,
+		},
+		{
+			name:   "NotReceivesClosed",
+			f:      func(tb testing.TB) { NotReceives(tb, closed, time.Second) },
+			expect: `^Expected no value, but channel was closed after \d+s?$`,
+		},
+		{
+			name: "ClosedValue",
+			f: func(tb testing.TB) {
+				ch := make(chan int, 1)
+				ch <- 1
+				Closed(tb, ch, time.Second)
+			},
+			expect: `^Expected channel to be closed, but received 1 after \d+s?$`,
+		},
+		{
+			name:   "ClosedTimeout",
+			f:      func(tb testing.TB) { Closed(tb, make(chan int), time.Millisecond) },
+			expect: `^Channel was not closed within \d+ms$`,
+		},
+		{
+			name: "ReceivesAllTimeout",
+			f: func(tb testing.TB) {
+				ch := make(chan int, 1)
+				ch <- 1
+				ReceivesAll(tb, ch, 2, time.Millisecond)
+			},
+			expect: `^Received 1 of 2 values within \d+ms: \[1\]$`,
+		},
+		{
+			name: "ReceivesAllClosed",
+			f: func(tb testing.TB) {
+				ch := make(chan int, 1)
+				ch <- 1
+				close(ch)
+				ReceivesAll(tb, ch, 2, time.Second)
+			},
+			expect: `^Channel was closed after \d+s?, received 1 of 2 values: \[1\]$`,
+		},
+		{
+			name:   "ReceivesAllNegative",
+			f:      func(tb testing.TB) { ReceivesAll(tb, make(chan int), -1, time.Second) },
+			expect: `^Invalid count -1, must not be negative$`,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs := failureMessages(t, tc.f)
+			if len(msgs) != 1 {
+				t.Fatalf("expected 1 failure, got %d: %q", len(msgs), msgs)
+			}
+			True(t, regexp.MustCompile(tc.expect).MatchString(msgs[0]),
+				"message %q does not match %q", msgs[0], tc.expect)
+		})
+	}
+}
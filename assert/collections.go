@@ -0,0 +1,250 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// containsElement reports if s contains v. Elements are compared like [Equal].
+func containsElement[E any](s []E, v E) bool {
+	for _, item := range s {
+		if len(compare(item, v, nil)) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// containsValue reports if container contains element. If container is
+// a string, element must be a string and is checked as a substring.
+// If container is a map, element is checked as a key. If container is
+// a slice or an array, element is checked as an element. Keys and elements
+// are compared like [Equal]. It returns false for ok, if container is
+// not supported.
+func containsValue(container, element any) (found, ok bool) {
+	cv := reflect.ValueOf(container)
+	switch cv.Kind() {
+	case reflect.String:
+		ev := reflect.ValueOf(element)
+		if ev.Kind() != reflect.String {
+			return false, false
+		}
+		return strings.Contains(cv.String(), ev.String()), true
+	case reflect.Map:
+		iter := cv.MapRange()
+		for iter.Next() {
+			if len(compare(iter.Key().Interface(), element, nil)) == 0 {
+				return true, true
+			}
+		}
+		return false, true
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < cv.Len(); i++ {
+			if len(compare(cv.Index(i).Interface(), element, nil)) == 0 {
+				return true, true
+			}
+		}
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// containsFallback returns failure message for [Contains] and [NotContains].
+func containsFallback(container, element any, not string) string {
+	what := ""
+	if reflect.ValueOf(container).Kind() == reflect.Map {
+		what = "key "
+	}
+	return fmt.Sprintf("%s should %scontain %s%s",
+		formatValue(reflect.ValueOf(container)), not,
+		what, formatValue(reflect.ValueOf(element)))
+}
+
+// Contains asserts that container contains element. If container is
+// a string, element must be its substring. If container is a map,
+// element must be one of its keys. If container is a slice or an array,
+// element must be one of its elements. Keys and elements are compared
+// like [Equal].
+//
+//	assert.Contains(t, names, "command1")
+//	assert.Contains(t, out, "Usage:")
+func Contains(t testing.TB, container, element any, args ...any) bool {
+	t.Helper()
+	found, ok := containsValue(container, element)
+	if !ok {
+		t.Errorf("Cannot check if %T contains %T", container, element)
+		return false
+	}
+	if found {
+		return true
+	}
+	t.Error(msgf(containsFallback(container, element, ""), args...))
+	return false
+}
+
+// NotContains asserts that container does not contain element.
+// See [Contains] for supported containers.
+func NotContains(t testing.TB, container, element any, args ...any) bool {
+	t.Helper()
+	found, ok := containsValue(container, element)
+	if !ok {
+		t.Errorf("Cannot check if %T contains %T", container, element)
+		return false
+	}
+	if !found {
+		return true
+	}
+	t.Error(msgf(containsFallback(container, element, "NOT "), args...))
+	return false
+}
+
+// HasKey asserts that map m contains key.
+func HasKey[M ~map[K]V, K comparable, V any](t testing.TB, m M, key K, args ...any) bool {
+	t.Helper()
+	if _, ok := m[key]; ok {
+		return true
+	}
+	fallback := fmt.Sprintf("Map should contain key %s", formatValue(reflect.ValueOf(key)))
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// multisetDiff returns elements of x which are not in y and elements
+// of y which are not in x, taking duplicates into account.
+func multisetDiff[E any](x, y []E) ([]E, []E) {
+	matched := make([]bool, len(y))
+	var extraX []E
+outer:
+	for _, xv := range x {
+		for j, yv := range y {
+			if !matched[j] && len(compare(xv, yv, nil)) == 0 {
+				matched[j] = true
+				continue outer
+			}
+		}
+		extraX = append(extraX, xv)
+	}
+	var extraY []E
+	for j, yv := range y {
+		if !matched[j] {
+			extraY = append(extraY, yv)
+		}
+	}
+	return extraX, extraY
+}
+
+// ElementsMatch asserts that expected and actual contain the same
+// elements, ignoring their order. Duplicate elements must occur the
+// same number of times in both. On failure missing and extra
+// elements are reported.
+//
+//	assert.ElementsMatch(t, []string{"b", "a"}, []string{"a", "b"})
+func ElementsMatch[S ~[]E, E any](t testing.TB, expected, actual S, args ...any) bool {
+	t.Helper()
+	missing, extra := multisetDiff(expected, actual)
+	if len(missing) == 0 && len(extra) == 0 {
+		return true
+	}
+	var b strings.Builder
+	b.WriteString("Elements do not match:\n")
+	for _, v := range missing {
+		fmt.Fprintf(&b, "  - %s\n", formatValue(reflect.ValueOf(v)))
+	}
+	for _, v := range extra {
+		fmt.Fprintf(&b, "  + %s\n", formatValue(reflect.ValueOf(v)))
+	}
+	t.Error(msgf(b.String(), args...))
+	return false
+}
+
+// Subset asserts that all elements of subset are present in s.
+//
+//	assert.Subset(t, flags, []string{"help", "version"})
+func Subset[S ~[]E, E any](t testing.TB, s, subset S, args ...any) bool {
+	t.Helper()
+	var missing []E
+	for _, v := range subset {
+		if !containsElement(s, v) {
+			missing = append(missing, v)
+		}
+	}
+	if len(missing) == 0 {
+		return true
+	}
+	fallback := fmt.Sprintf("%s should contain %s, missing %s",
+		formatValue(reflect.ValueOf(s)),
+		formatValue(reflect.ValueOf(subset)),
+		formatValue(reflect.ValueOf(missing)))
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// length returns length of v, if v is a string, slice, array, map or channel.
+func length(v any) (int, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return rv.Len(), true
+	case reflect.Pointer:
+		if !rv.IsNil() && rv.Elem().Kind() == reflect.Array {
+			return rv.Elem().Len(), true
+		}
+	default:
+	}
+	return 0, false
+}
+
+// Len asserts that v has length n. v must be a string,
+// slice, array, pointer to an array, map or a channel.
+func Len[T any](t testing.TB, v T, n int, args ...any) bool {
+	t.Helper()
+	l, ok := length(v)
+	if !ok {
+		t.Errorf("Cannot get length of %T", v)
+		return false
+	}
+	if l == n {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected length %d, got %d: %s", n, l, formatValue(reflect.ValueOf(v)))
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// isEmpty reports if v is empty. Strings, slices, arrays, maps and
+// channels are empty if their length is zero. Other values are empty
+// if they are zero values, for example nil pointers.
+func isEmpty(v any) bool {
+	if l, ok := length(v); ok {
+		return l == 0
+	}
+	rv := reflect.ValueOf(v)
+	return !rv.IsValid() || rv.IsZero()
+}
+
+// Empty asserts that v is empty. Strings, slices, arrays, maps and
+// channels are empty if their length is zero. Other values are empty
+// if they are zero values.
+func Empty[T any](t testing.TB, v T, args ...any) bool {
+	t.Helper()
+	if isEmpty(v) {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected to be empty, got %s", formatValue(reflect.ValueOf(v)))
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// NotEmpty asserts that v is not empty. See [Empty] for details.
+func NotEmpty[T any](t testing.TB, v T, args ...any) bool {
+	t.Helper()
+	if !isEmpty(v) {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected not to be empty, got %s", formatValue(reflect.ValueOf(v)))
+	t.Error(msgf(fallback, args...))
+	return false
+}
@@ -0,0 +1,103 @@
+package assert
+
+import (
+	"testing"
+)
+
+func TestCollections(t *testing.T) {
+	Contains(t, []string{"a", "b"}, "b")
+	Contains(t, []testNested{{Values: []int{1}}}, testNested{Values: []int{1}})
+	NotContains(t, []int{1, 2}, 3)
+	Contains(t, "foo bar", "bar")
+	NotContains(t, "foo bar", "baz")
+	Contains(t, map[string]int{"a": 1}, "a")
+	NotContains(t, map[string]int{"a": 1}, "b")
+	Contains(t, [2]int{1, 2}, 2)
+	HasKey(t, map[string]int{"a": 1}, "a")
+	ElementsMatch(t, []int{1, 2, 2, 3}, []int{2, 3, 2, 1})
+	Subset(t, []string{"a", "b", "c"}, []string{"c", "a"})
+	Len(t, []int{1, 2}, 2)
+	Len(t, "foo", 3)
+	Len(t, map[int]int{1: 1}, 1)
+	Len(t, &[3]int{}, 3)
+	Empty(t, "")
+	Empty(t, []int{})
+	Empty[*int](t, nil)
+	Empty(t, 0)
+	NotEmpty(t, []int{0})
+	NotEmpty(t, 1)
+}
+
+func TestCollections_FailureMessages(t *testing.T) {
+	type testCase struct {
+		name   string
+		f      func(tb testing.TB)
+		expect string
+	}
+	tt := []testCase{
+		{
+			name:   "Contains",
+			f:      func(tb testing.TB) { Contains(tb, []string{"a"}, "b") },
+			expect: `[a] should contain "b"`,
+		},
+		{
+			name:   "ContainsString",
+			f:      func(tb testing.TB) { Contains(tb, "foo", "bar") },
+			expect: `"foo" should contain "bar"`,
+		},
+		{
+			name:   "ContainsMapKey",
+			f:      func(tb testing.TB) { Contains(tb, map[string]int{"a": 1}, "b") },
+			expect: `map[a:1] should contain key "b"`,
+		},
+		{
+			name:   "NotContains",
+			f:      func(tb testing.TB) { NotContains(tb, []int{1, 2}, 2) },
+			expect: "[1 2] should NOT contain 2",
+		},
+		{
+			name:   "ContainsUnsupported",
+			f:      func(tb testing.TB) { Contains(tb, 1, 1) },
+			expect: "Cannot check if int contains int",
+		},
+		{
+			name:   "HasKey",
+			f:      func(tb testing.TB) { HasKey(tb, map[string]int{}, "b") },
+			expect: `Map should contain key "b"`,
+		},
+		{
+			name:   "ElementsMatch",
+			f:      func(tb testing.TB) { ElementsMatch(tb, []int{1, 2, 2}, []int{2, 3, 1}) },
+			expect: "Elements do not match:\n  - 2\n  + 3\n",
+		},
+		{
+			name:   "Subset",
+			f:      func(tb testing.TB) { Subset(tb, []int{1, 2}, []int{2, 3, 4}) },
+			expect: "[1 2] should contain [2 3 4], missing [3 4]",
+		},
+		{
+			name:   "Len",
+			f:      func(tb testing.TB) { Len(tb, []int{1}, 2) },
+			expect: "Expected length 2, got 1: [1]",
+		},
+		{
+			name:   "LenUnsupported",
+			f:      func(tb testing.TB) { Len(tb, 1, 2) },
+			expect: "Cannot get length of int",
+		},
+		{
+			name:   "Empty",
+			f:      func(tb testing.TB) { Empty(tb, "foo") },
+			expect: `Expected to be empty, got "foo"`,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs := failureMessages(t, tc.f)
+			if len(msgs) != 1 {
+				t.Fatalf("expected 1 failure, got %d: %q", len(msgs), msgs)
+			}
+			Equal(t, tc.expect, msgs[0])
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Number of unchanged lines to show around changes.
+const diffContext = 3
+
+// diffOp is type of change of a single line.
+type diffOp byte
+
+const (
+	diffEqual  diffOp = ' '
+	diffDelete diffOp = '-'
+	diffInsert diffOp = '+'
+)
+
+type diffLine struct {
+	op   diffOp
+	text string
+}
+
+// splitLines splits s into lines, without line endings.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// splitLinesKeepEnds splits s into lines, keeping line endings, so that
+// lines which differ only by a missing newline at the end are not equal.
+func splitLinesKeepEnds(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// lineDiff computes line-by-line diff between a and b using
+// longest common subsequence.
+func lineDiff(a, b []string) []diffLine {
+	// Strip common prefix and suffix, which is usually most of
+	// the input, so that LCS table is kept small.
+	var prefix, suffix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	rv := make([]diffLine, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		rv = append(rv, diffLine{op: diffEqual, text: line})
+	}
+
+	x, y := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var i, j int
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			rv = append(rv, diffLine{op: diffEqual, text: x[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			rv = append(rv, diffLine{op: diffDelete, text: x[i]})
+			i++
+		default:
+			rv = append(rv, diffLine{op: diffInsert, text: y[j]})
+			j++
+		}
+	}
+	for ; i < len(x); i++ {
+		rv = append(rv, diffLine{op: diffDelete, text: x[i]})
+	}
+	for ; j < len(y); j++ {
+		rv = append(rv, diffLine{op: diffInsert, text: y[j]})
+	}
+
+	for _, line := range a[len(a)-suffix:] {
+		rv = append(rv, diffLine{op: diffEqual, text: line})
+	}
+	return rv
+}
+
+// unifiedDiff returns unified diff between a and b.
+// Empty string is returned if a and b are equal. Like GNU diff,
+// lines without newline at the end are followed by a
+// "\ No newline at end of file" marker.
+func unifiedDiff(aName, bName, a, b string) string {
+	lines := lineDiff(splitLinesKeepEnds(a), splitLinesKeepEnds(b))
+
+	var w strings.Builder
+	var aLine, bLine int
+	for start := 0; start < len(lines); {
+		// Find next change.
+		for start < len(lines) && lines[start].op == diffEqual {
+			start++
+			aLine++
+			bLine++
+		}
+		if start == len(lines) {
+			break
+		}
+
+		// Extend hunk until there are more than 2*diffContext unchanged lines.
+		end := start
+		for unchanged := 0; end < len(lines) && unchanged <= 2*diffContext; end++ {
+			if lines[end].op == diffEqual {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+		}
+		for end > start && lines[end-1].op == diffEqual {
+			end--
+		}
+
+		ctxStart := max(start-diffContext, 0)
+		ctxEnd := min(end+diffContext, len(lines))
+		aStart, bStart := aLine-(start-ctxStart), bLine-(start-ctxStart)
+		var aCount, bCount int
+		for _, l := range lines[ctxStart:ctxEnd] {
+			if l.op != diffInsert {
+				aCount++
+			}
+			if l.op != diffDelete {
+				bCount++
+			}
+		}
+
+		if w.Len() == 0 {
+			fmt.Fprintf(&w, "--- %s\n+++ %s\n", aName, bName)
+		}
+		fmt.Fprintf(&w, "@@ -%d,%d +%d,%d @@\n", aStart+1, aCount, bStart+1, bCount)
+		for _, l := range lines[ctxStart:ctxEnd] {
+			w.WriteByte(byte(l.op))
+			w.WriteString(l.text)
+			if !strings.HasSuffix(l.text, "\n") {
+				w.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+
+		for _, l := range lines[start:end] {
+			if l.op != diffInsert {
+				aLine++
+			}
+			if l.op != diffDelete {
+				bLine++
+			}
+		}
+		start = end
+	}
+	return w.String()
+}
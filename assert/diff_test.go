@@ -0,0 +1,55 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Run("Equal", func(t *testing.T) {
+		if d := unifiedDiff("a", "b", "x\ny\n", "x\ny\n"); d != "" {
+			t.Errorf("expected no diff, got:\n%s", d)
+		}
+	})
+	t.Run("TrailingNewline", func(t *testing.T) {
+		expect := "--- a\n+++ b\n@@ -1,1 +1,1 @@\n-x\n+x\n\\ No newline at end of file\n"
+		if d := unifiedDiff("a", "b", "x\n", "x"); d != expect {
+			t.Errorf("expected:\n%s\ngot:\n%s", expect, d)
+		}
+		expect = "--- a\n+++ b\n@@ -1,1 +1,1 @@\n-x\n\\ No newline at end of file\n+x\n"
+		if d := unifiedDiff("a", "b", "x", "x\n"); d != expect {
+			t.Errorf("expected:\n%s\ngot:\n%s", expect, d)
+		}
+	})
+	t.Run("Hunks", func(t *testing.T) {
+		var a, b []string
+		for i := 0; i < 20; i++ {
+			a = append(a, strings.Repeat("a", i+1))
+			b = append(b, strings.Repeat("a", i+1))
+		}
+		b[1] = "changed"
+		b = append(b[:15], b[16:]...)
+		expect := `--- a
++++ b
+@@ -1,5 +1,5 @@
+ a
+-aa
++changed
+ aaa
+ aaaa
+ aaaaa
+@@ -13,7 +13,6 @@
+ aaaaaaaaaaaaa
+ aaaaaaaaaaaaaa
+ aaaaaaaaaaaaaaa
+-aaaaaaaaaaaaaaaa
+ aaaaaaaaaaaaaaaaa
+ aaaaaaaaaaaaaaaaaa
+ aaaaaaaaaaaaaaaaaaa
+`
+		got := unifiedDiff("a", "b", strings.Join(a, "\n"), strings.Join(b, "\n"))
+		if got != expect {
+			t.Errorf("expected:\n%s\ngot:\n%s", expect, got)
+		}
+	})
+}
@@ -0,0 +1,27 @@
+// Package assert provides assertion helpers for tests.
+//
+// All assertions in this package mark the test as failed and continue
+// execution of the test. They return true if assertion passed, so that
+// callers can decide to stop the test early. Use [github.com/tprasadtp/pkg/assert/require]
+// for assertions which stop the test on failure.
+//
+// All assertions accept optional args, which can be used to customize
+// the error message. First argument must be a format string.
+//
+//	assert.True(t, ok, "%s => expected ok", t.Name())
+//
+// Use [Golden] and [GoldenFS] to compare output with golden files in testdata
+// directory. Golden files can be updated by running tests with -assert.update
+// flag or with GO_TEST_UPDATE_GOLDEN=true environment variable.
+//
+//	go test ./... -assert.update
+//
+// Use [Group] to collect failures of multiple assertions and report them
+// as a single failure along with their locations.
+//
+//	assert.Group(t, func(g *assert.G) {
+//		assert.Equal(g, "foo", v.Name)
+//		require.NoErrors(g, v.Validate()) // stops the group, not the test.
+//		assert.True(g, v.Enabled)
+//	})
+package assert
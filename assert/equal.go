@@ -0,0 +1,355 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Equal asserts that expected and actual are equal.
+//
+// Structs, maps, slices, arrays and pointers are compared recursively,
+// including unexported fields. Types with an Equal(T) bool method
+// like [time.Time] are compared using it. On failure, all
+// differences are reported with their paths. Multi-line strings
+// are reported as a line based diff.
+//
+// args can include [EqualOption] values to customize comparison,
+// remaining args are used to customize the error message.
+//
+//	assert.Equal(t, expected, got, "%s => info mismatch", t.Name())
+func Equal[T any](t testing.TB, expected, actual T, args ...any) bool {
+	t.Helper()
+	opts, args := splitOptions(args)
+	diffs := compare(expected, actual, opts)
+	if len(diffs) == 0 {
+		return true
+	}
+	fallback := "Values are not equal:\n" + formatDiffs(diffs)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// NotEqual asserts that expected and actual are not equal.
+// Like [Equal], args can include [EqualOption] values.
+func NotEqual[T any](t testing.TB, expected, actual T, args ...any) bool {
+	t.Helper()
+	opts, args := splitOptions(args)
+	if len(compare(expected, actual, opts)) != 0 {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected values to differ, but both are: %s",
+		formatValue(reflect.ValueOf(actual)))
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// difference is a single difference found while comparing values.
+type difference struct {
+	path     string
+	expected string
+	actual   string
+	diff     string // pre-formatted diff, used for multi-line strings.
+}
+
+// compare returns all differences between x and y.
+func compare(x, y any, opts *equalOptions) []difference {
+	if reflect.DeepEqual(x, y) {
+		return nil
+	}
+	if opts == nil {
+		opts = &equalOptions{}
+	}
+	c := &comparer{
+		opts:    opts,
+		visited: make(map[visit]bool),
+	}
+	c.compare("", reflect.ValueOf(x), reflect.ValueOf(y))
+	return c.diffs
+}
+
+// formatDiffs formats differences one per line.
+func formatDiffs(diffs []difference) string {
+	var b strings.Builder
+	for _, d := range diffs {
+		path := d.path
+		if path == "" {
+			path = "(value)"
+		}
+		if d.diff != "" {
+			fmt.Fprintf(&b, "  %s:\n", path)
+			for _, line := range splitLines(d.diff) {
+				fmt.Fprintf(&b, "    %s\n", line)
+			}
+			continue
+		}
+		fmt.Fprintf(&b, "  %s: expected %s, got %s\n", path, d.expected, d.actual)
+	}
+	return b.String()
+}
+
+// visit is used to detect cycles while comparing pointers and maps.
+type visit struct {
+	x, y uintptr
+	typ  reflect.Type
+}
+
+type comparer struct {
+	opts    *equalOptions
+	diffs   []difference
+	visited map[visit]bool
+}
+
+func (c *comparer) report(path string, x, y reflect.Value) {
+	c.diffs = append(c.diffs, difference{
+		path:     path,
+		expected: formatValue(x),
+		actual:   formatValue(y),
+	})
+}
+
+func (c *comparer) reportf(path, expected, actual string) {
+	c.diffs = append(c.diffs, difference{
+		path:     path,
+		expected: expected,
+		actual:   actual,
+	})
+}
+
+// equalMethod returns result of x.Equal(y) if type implements
+// Equal(T) bool method.
+func equalMethod(x, y reflect.Value) (bool, bool) {
+	if !x.CanInterface() || !y.CanInterface() {
+		return false, false
+	}
+	m := x.MethodByName("Equal")
+	if !m.IsValid() {
+		return false, false
+	}
+	mt := m.Type()
+	if mt.NumIn() != 1 || mt.NumOut() != 1 ||
+		mt.In(0) != x.Type() || mt.Out(0).Kind() != reflect.Bool {
+		return false, false
+	}
+	return m.Call([]reflect.Value{y})[0].Bool(), true
+}
+
+//nolint:gocognit,gocyclo,cyclop,funlen // walks all reflect kinds.
+func (c *comparer) compare(path string, x, y reflect.Value) {
+	if !x.IsValid() || !y.IsValid() {
+		if x.IsValid() != y.IsValid() {
+			c.report(path, x, y)
+		}
+		return
+	}
+
+	if x.Type() != y.Type() {
+		c.reportf(path,
+			fmt.Sprintf("%s(%s)", x.Type(), formatValue(x)),
+			fmt.Sprintf("%s(%s)", y.Type(), formatValue(y)))
+		return
+	}
+
+	if ok, found := equalMethod(x, y); found {
+		if !ok {
+			c.report(path, x, y)
+		}
+		return
+	}
+
+	switch x.Kind() {
+	case reflect.Pointer:
+		if x.IsNil() || y.IsNil() {
+			if x.IsNil() != y.IsNil() {
+				c.report(path, x, y)
+			}
+			return
+		}
+		if x.Pointer() == y.Pointer() {
+			return
+		}
+		v := visit{x: x.Pointer(), y: y.Pointer(), typ: x.Type()}
+		if c.visited[v] {
+			return
+		}
+		c.visited[v] = true
+		c.compare(path, x.Elem(), y.Elem())
+	case reflect.Interface:
+		if x.IsNil() || y.IsNil() {
+			if x.IsNil() != y.IsNil() {
+				c.report(path, x, y)
+			}
+			return
+		}
+		c.compare(path, x.Elem(), y.Elem())
+	case reflect.Struct:
+		for i := 0; i < x.NumField(); i++ {
+			if c.opts.ignoreField(x.Type(), i) {
+				continue
+			}
+			name := x.Type().Field(i).Name
+			c.compare(path+"."+name, x.Field(i), y.Field(i))
+		}
+	case reflect.Slice:
+		if c.opts.equateEmpty && x.Len() == 0 && y.Len() == 0 {
+			return
+		}
+		if x.IsNil() != y.IsNil() {
+			c.reportf(path, formatNilOrEmpty(x), formatNilOrEmpty(y))
+			return
+		}
+		if x.Pointer() == y.Pointer() && x.Len() == y.Len() {
+			return
+		}
+		c.compareSequence(path, c.opts.sorted(x), c.opts.sorted(y))
+	case reflect.Array:
+		c.compareSequence(path, c.opts.sorted(x), c.opts.sorted(y))
+	case reflect.Map:
+		if c.opts.equateEmpty && x.Len() == 0 && y.Len() == 0 {
+			return
+		}
+		if x.IsNil() != y.IsNil() {
+			c.reportf(path, formatNilOrEmpty(x), formatNilOrEmpty(y))
+			return
+		}
+		if x.Pointer() == y.Pointer() {
+			return
+		}
+		v := visit{x: x.Pointer(), y: y.Pointer(), typ: x.Type()}
+		if c.visited[v] {
+			return
+		}
+		c.visited[v] = true
+		c.compareMap(path, x, y)
+	case reflect.String:
+		xs, ys := x.String(), y.String()
+		if xs == ys {
+			return
+		}
+		if strings.Contains(xs, "\n") || strings.Contains(ys, "\n") {
+			c.diffs = append(c.diffs, difference{
+				path: path,
+				diff: unifiedDiff("expected", "actual", xs, ys),
+			})
+			return
+		}
+		c.report(path, x, y)
+	case reflect.Bool:
+		if x.Bool() != y.Bool() {
+			c.report(path, x, y)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if x.Int() != y.Int() {
+			c.report(path, x, y)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if x.Uint() != y.Uint() {
+			c.report(path, x, y)
+		}
+	case reflect.Float32, reflect.Float64:
+		if !c.opts.floatEqual(x.Float(), y.Float()) {
+			c.report(path, x, y)
+		}
+	case reflect.Complex64, reflect.Complex128:
+		xc, yc := x.Complex(), y.Complex()
+		if !c.opts.floatEqual(real(xc), real(yc)) || !c.opts.floatEqual(imag(xc), imag(yc)) {
+			c.report(path, x, y)
+		}
+	case reflect.Func:
+		// Functions are only equal if both are nil.
+		if !x.IsNil() || !y.IsNil() {
+			c.reportf(path, formatFunc(x), formatFunc(y))
+		}
+	case reflect.Chan, reflect.UnsafePointer:
+		if x.Pointer() != y.Pointer() {
+			c.report(path, x, y)
+		}
+	default:
+		c.report(path, x, y)
+	}
+}
+
+// compareSequence compares slices and arrays element by element.
+func (c *comparer) compareSequence(path string, x, y reflect.Value) {
+	n := min(x.Len(), y.Len())
+	for i := 0; i < n; i++ {
+		c.compare(path+"["+strconv.Itoa(i)+"]", x.Index(i), y.Index(i))
+	}
+	for i := n; i < x.Len(); i++ {
+		c.reportf(path+"["+strconv.Itoa(i)+"]", formatValue(x.Index(i)), "<missing>")
+	}
+	for i := n; i < y.Len(); i++ {
+		c.reportf(path+"["+strconv.Itoa(i)+"]", "<missing>", formatValue(y.Index(i)))
+	}
+}
+
+// compareMap compares maps key by key. Keys are sorted by their
+// formatted value so that output is deterministic.
+func (c *comparer) compareMap(path string, x, y reflect.Value) {
+	keys := x.MapKeys()
+	for _, k := range y.MapKeys() {
+		if !x.MapIndex(k).IsValid() {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return formatValue(keys[i]) < formatValue(keys[j])
+	})
+
+	for _, k := range keys {
+		keyPath := path + "[" + formatValue(k) + "]"
+		xv, yv := x.MapIndex(k), y.MapIndex(k)
+		switch {
+		case !yv.IsValid():
+			c.reportf(keyPath, formatValue(xv), "<missing>")
+		case !xv.IsValid():
+			c.reportf(keyPath, "<missing>", formatValue(yv))
+		default:
+			c.compare(keyPath, xv, yv)
+		}
+	}
+}
+
+// formatValue formats a value for failure messages.
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<nil>"
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(v.String())
+	case reflect.Pointer:
+		if v.IsNil() {
+			return "<nil>"
+		}
+		if v.Elem().Kind() == reflect.Struct {
+			return "&" + formatValue(v.Elem())
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return "<nil>"
+		}
+		return formatValue(v.Elem())
+	case reflect.Struct:
+		return fmt.Sprintf("%+v", v)
+	default:
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func formatNilOrEmpty(v reflect.Value) string {
+	if v.IsNil() {
+		return fmt.Sprintf("%s(nil)", v.Type())
+	}
+	return formatValue(v)
+}
+
+func formatFunc(v reflect.Value) string {
+	if v.IsNil() {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s(%#x)", v.Type(), v.Pointer())
+}
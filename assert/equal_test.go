@@ -0,0 +1,151 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type testNested struct {
+	Values []int
+	Labels map[string]string
+}
+
+type testStruct struct {
+	Name    string
+	Count   int
+	Nested  *testNested
+	private bool
+}
+
+func TestEqual(t *testing.T) {
+	v := testStruct{
+		Name:  "foo",
+		Count: 1,
+		Nested: &testNested{
+			Values: []int{1, 2, 3},
+			Labels: map[string]string{"a": "b"},
+		},
+	}
+	Equal(t, v, v)
+	Equal(t, 1, 1)
+	Equal(t, "foo", "foo")
+	Equal(t, []string{"a"}, []string{"a"})
+	Equal[any](t, nil, nil)
+	NotEqual(t, 1, 2)
+	NotEqual(t, []string{"a"}, []string{"b"})
+
+	now := time.Now()
+	Equal(t, now, now.Round(0))
+}
+
+func TestCompare(t *testing.T) {
+	type testCase struct {
+		name     string
+		expected any
+		actual   any
+		diffs    []string
+	}
+	tt := []testCase{
+		{
+			name:     "equal",
+			expected: testStruct{Name: "foo"},
+			actual:   testStruct{Name: "foo"},
+		},
+		{
+			name:     "struct-fields",
+			expected: testStruct{Name: "foo", Count: 1},
+			actual:   testStruct{Name: "bar", Count: 1, private: true},
+			diffs: []string{
+				`.Name: expected "foo", got "bar"`,
+				`.private: expected false, got true`,
+			},
+		},
+		{
+			name: "nested",
+			expected: testStruct{Nested: &testNested{
+				Values: []int{1, 2, 3},
+				Labels: map[string]string{"a": "b", "c": "d"},
+			}},
+			actual: testStruct{Nested: &testNested{
+				Values: []int{1, 5},
+				Labels: map[string]string{"a": "x", "e": "f"},
+			}},
+			diffs: []string{
+				`.Nested.Values[1]: expected 2, got 5`,
+				`.Nested.Values[2]: expected 3, got <missing>`,
+				`.Nested.Labels["a"]: expected "b", got "x"`,
+				`.Nested.Labels["c"]: expected "d", got <missing>`,
+				`.Nested.Labels["e"]: expected <missing>, got "f"`,
+			},
+		},
+		{
+			name:     "nil-pointer",
+			expected: testStruct{},
+			actual:   testStruct{Nested: &testNested{}},
+			diffs:    []string{`.Nested: expected <nil>, got &{Values:[] Labels:map[]}`},
+		},
+		{
+			name:     "nil-slice",
+			expected: []int(nil),
+			actual:   []int{},
+			diffs:    []string{`(value): expected []int(nil), got []`},
+		},
+		{
+			name:     "type-mismatch",
+			expected: any(1),
+			actual:   any(int64(1)),
+			diffs:    []string{`(value): expected int(1), got int64(1)`},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := splitLines(formatDiffs(compare(tc.expected, tc.actual, nil)))
+			if len(got) != len(tc.diffs) {
+				t.Fatalf("expected %d diffs, got %d:\n%s",
+					len(tc.diffs), len(got), strings.Join(got, "\n"))
+			}
+			for i := range got {
+				if strings.TrimSpace(got[i]) != tc.diffs[i] {
+					t.Errorf("at index %d expected %s, got %s", i, tc.diffs[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCompare_MultilineString(t *testing.T) {
+	diffs := compare("a\nb\nc\n", "a\nx\nc\n", nil)
+	if len(diffs) != 1 {
+		t.Fatalf("expected 1 diff, got %d", len(diffs))
+	}
+	expect := "--- expected\n+++ actual\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n"
+	if diffs[0].diff != expect {
+		t.Errorf("expected diff:\n%s\ngot:\n%s", expect, diffs[0].diff)
+	}
+}
+
+func TestCompare_TrailingNewline(t *testing.T) {
+	diffs := compare("a\nb\n", "a\nb", nil)
+	if len(diffs) != 1 {
+		t.Fatalf("expected 1 diff, got %d", len(diffs))
+	}
+	expect := "--- expected\n+++ actual\n@@ -1,2 +1,2 @@\n a\n-b\n+b\n\\ No newline at end of file\n"
+	if diffs[0].diff != expect {
+		t.Errorf("expected diff:\n%s\ngot:\n%s", expect, diffs[0].diff)
+	}
+}
+
+func TestCompare_Cycle(t *testing.T) {
+	type node struct {
+		Next  *node
+		Value int
+	}
+	x := &node{Value: 1}
+	x.Next = x
+	y := &node{Value: 2}
+	y.Next = y
+	if diffs := compare(x, y, nil); len(diffs) != 1 {
+		t.Errorf("expected 1 diff, got:\n%s", formatDiffs(diffs))
+	}
+}
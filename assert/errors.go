@@ -0,0 +1,101 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// Maximum depth of error tree to print. This guards against
+// errors which unwrap to themselves.
+const maxErrorTreeDepth = 32
+
+// formatErrorTree formats err and all errors it wraps as an indented tree,
+// one error per line along with its type.
+//
+//	*errors.joinError: "listener tcp: no such process\nlistener udp: no such process"
+//	  *fmt.wrapError: "listener tcp: no such process"
+//	    syscall.Errno: "no such process"
+//	  *fmt.wrapError: "listener udp: no such process"
+//	    syscall.Errno: "no such process"
+func formatErrorTree(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+	var b strings.Builder
+	writeErrorTree(&b, err, 0)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func writeErrorTree(b *strings.Builder, err error, depth int) {
+	indent := strings.Repeat("  ", depth)
+	if depth >= maxErrorTreeDepth {
+		fmt.Fprintf(b, "%s...\n", indent)
+		return
+	}
+	fmt.Fprintf(b, "%s%T: %q\n", indent, err, err.Error())
+	switch v := err.(type) { //nolint:errorlint // we need direct unwrap methods.
+	case interface{ Unwrap() error }:
+		if inner := v.Unwrap(); inner != nil {
+			writeErrorTree(b, inner, depth+1)
+		}
+	case interface{ Unwrap() []error }:
+		for _, inner := range v.Unwrap() {
+			if inner != nil {
+				writeErrorTree(b, inner, depth+1)
+			}
+		}
+	}
+}
+
+// ErrorAs asserts that any error in err's tree is of type T, and returns it.
+// If no such error is found, zero value of T is returned.
+//
+//	errno := assert.ErrorAs[syscall.Errno](t, err)
+func ErrorAs[T error](t testing.TB, err error, args ...any) T {
+	t.Helper()
+	var target T
+	if errors.As(err, &target) {
+		return target
+	}
+	// Use reflect, as %T of zero value of an interface type is <nil>.
+	fallback := fmt.Sprintf("Error tree should contain error of type %s:\n%s",
+		reflect.TypeOf((*T)(nil)).Elem(), formatErrorTree(err))
+	t.Error(msgf(fallback, args...))
+	return target
+}
+
+// ErrorContains asserts that err is not nil and its message contains substr.
+//
+//	assert.ErrorContains(t, err, "no such file")
+func ErrorContains(t testing.TB, err error, substr string, args ...any) bool {
+	t.Helper()
+	if err != nil && strings.Contains(err.Error(), substr) {
+		return true
+	}
+	fallback := fmt.Sprintf("Error message should contain %q:\n%s", substr, formatErrorTree(err))
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// ErrorMatches asserts that err is not nil and its message matches
+// regular expression pattern.
+//
+//	assert.ErrorMatches(t, err, `^invalid GUID\(Data[1-4]\)`)
+func ErrorMatches(t testing.TB, err error, pattern string, args ...any) bool {
+	t.Helper()
+	re, rerr := regexp.Compile(pattern)
+	if rerr != nil {
+		t.Errorf("Invalid pattern %q: %s", pattern, rerr)
+		return false
+	}
+	if err != nil && re.MatchString(err.Error()) {
+		return true
+	}
+	fallback := fmt.Sprintf("Error message should match %q:\n%s", pattern, formatErrorTree(err))
+	t.Error(msgf(fallback, args...))
+	return false
+}
@@ -0,0 +1,62 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"syscall"
+	"testing"
+)
+
+func TestFormatErrorTree(t *testing.T) {
+	err := errors.Join(
+		fmt.Errorf("listener tcp: %w", syscall.ESRCH),
+		fmt.Errorf("listener udp: %w", fs.ErrNotExist),
+	)
+	expect := `*errors.joinError: "listener tcp: no such process\nlistener udp: file does not exist"
+  *fmt.wrapError: "listener tcp: no such process"
+    syscall.Errno: "no such process"
+  *fmt.wrapError: "listener udp: file does not exist"
+    *errors.errorString: "file does not exist"`
+	Equal(t, expect, formatErrorTree(err))
+	Equal(t, "<nil>", formatErrorTree(nil))
+}
+
+type testCyclicError struct{}
+
+func (e *testCyclicError) Error() string { return "cyclic" }
+func (e *testCyclicError) Unwrap() error { return e }
+
+func TestFormatErrorTree_Cycle(t *testing.T) {
+	lines := splitLines(formatErrorTree(&testCyclicError{}))
+	Equal(t, maxErrorTreeDepth+1, len(lines))
+}
+
+func TestErrorAs(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", &fs.PathError{Op: "open", Path: "foo", Err: syscall.ENOENT})
+	pathErr := ErrorAs[*fs.PathError](t, err)
+	if pathErr == nil || pathErr.Path != "foo" {
+		t.Errorf("expected *fs.PathError, got %v", pathErr)
+	}
+	Equal(t, syscall.ENOENT, ErrorAs[syscall.Errno](t, err))
+
+	t.Run("InterfaceType", func(t *testing.T) {
+		type timeout interface {
+			error
+			Timeout() bool
+		}
+		msgs := failureMessages(t, func(tb testing.TB) {
+			ErrorAs[timeout](tb, errors.New("test error"))
+		})
+		Equal(t, []string{
+			"Error tree should contain error of type assert.timeout:\n" +
+				"*errors.errorString: \"test error\"",
+		}, msgs)
+	})
+}
+
+func TestErrorContains(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", syscall.ENOENT)
+	ErrorContains(t, err, "no such file")
+	ErrorMatches(t, err, `^wrapped: no such \w+`)
+}
@@ -0,0 +1,128 @@
+package assert
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tprasadtp/pkg/race"
+)
+
+// Maximum size of a single event accepted by [EventSink].
+const maxEventSize = 1 << 20
+
+// EventSink is a test HTTP server, which collects JSON events of type T
+// posted to it. This is useful to collect results from child processes,
+// which cannot report to the test directly, like services started by
+// the init system.
+//
+//	sink := assert.NewEventSink[TestEvent](t)
+//	cmd := exec.Command(os.Args[0], "-test.run=TestRemote")
+//	cmd.Env = append(os.Environ(), "GO_TEST_SERVER_ADDR="+sink.URL)
+//	_ = cmd.Start()
+//	events := sink.WaitEvents(2, 30*time.Second)
+//
+// Each event must be posted as a JSON document in the body of a POST request.
+// Invalid requests are reported as test failures.
+type EventSink[T any] struct {
+	// URL of the server, to which events must be posted.
+	URL string
+
+	t      testing.TB
+	server *httptest.Server
+
+	mu     sync.Mutex
+	events []T
+	notify chan struct{} // closed and replaced when an event is received.
+}
+
+// NewEventSink starts a new [EventSink], which is closed when the test completes.
+func NewEventSink[T any](t testing.TB) *EventSink[T] {
+	t.Helper()
+	s := &EventSink[T]{
+		t:      t,
+		notify: make(chan struct{}),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	t.Cleanup(s.server.Close)
+	return s
+}
+
+func (s *EventSink[T]) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		s.t.Errorf("EventSink: unsupported request method %s", r.Method)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxEventSize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		s.t.Errorf("EventSink: failed to read request: %s", err)
+		return
+	}
+
+	var event T
+	if err = json.Unmarshal(body, &event); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		s.t.Errorf("EventSink: invalid event %s: %s", formatBody(body), err)
+		return
+	}
+
+	s.mu.Lock()
+	s.events = append(s.events, event)
+	close(s.notify)
+	s.notify = make(chan struct{})
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Events returns all events received so far, in order they were received.
+func (s *EventSink[T]) Events() []T {
+	events, _ := s.snapshot()
+	return events
+}
+
+// snapshot returns events received so far and a channel, which is closed
+// when next event is received.
+func (s *EventSink[T]) snapshot() ([]T, <-chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rv := make([]T, len(s.events))
+	copy(rv, s.events)
+	return rv, s.notify
+}
+
+// WaitEvents asserts that at least n events are received within timeout
+// and returns all events received. On failure, events received so far are
+// returned. Timeout is scaled with [race.ScaleDuration].
+func (s *EventSink[T]) WaitEvents(n int, timeout time.Duration) []T {
+	s.t.Helper()
+	start := time.Now()
+	timer := time.NewTimer(race.ScaleDuration(timeout))
+	defer timer.Stop()
+	for {
+		events, notify := s.snapshot()
+		if len(events) >= n {
+			return events
+		}
+		select {
+		case <-notify:
+		case <-timer.C:
+			events = s.Events()
+			if len(events) >= n {
+				return events
+			}
+			s.t.Errorf("Received %d of %d events within %s: %s",
+				len(events), n, since(start), formatValue(reflect.ValueOf(events)))
+			return events
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package assert
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tprasadtp/pkg/assert/asserttest"
+)
+
+type testEvent struct {
+	Name    string `json:"name"`
+	Success bool   `json:"success"`
+}
+
+func postEvent(t *testing.T, url, body string) *http.Response {
+	t.Helper()
+	resp, err := http.Post(url, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to post event: %s", err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestEventSink(t *testing.T) {
+	sink := NewEventSink[testEvent](t)
+	go func() {
+		for _, name := range []string{"a", "b"} {
+			resp, err := http.Post(sink.URL, "application/json",
+				strings.NewReader(`{"name":"`+name+`","success":true}`))
+			if err == nil {
+				resp.Body.Close()
+			}
+		}
+	}()
+	events := sink.WaitEvents(2, 5*time.Second)
+	Equal(t, []testEvent{{Name: "a", Success: true}, {Name: "b", Success: true}}, events)
+	Equal(t, events, sink.Events())
+}
+
+func TestEventSink_FailureMessages(t *testing.T) {
+	t.Run("InvalidEvent", func(t *testing.T) {
+		var resp *http.Response
+		msgs := failureMessages(t, func(tb testing.TB) {
+			sink := NewEventSink[testEvent](tb)
+			resp = postEvent(t, sink.URL, "foo")
+		})
+		HTTPStatus(t, resp, http.StatusBadRequest)
+		Len(t, msgs, 1)
+		Contains(t, msgs[0], `EventSink: invalid event "foo"`)
+	})
+	t.Run("InvalidMethod", func(t *testing.T) {
+		var resp *http.Response
+		msgs := failureMessages(t, func(tb testing.TB) {
+			sink := NewEventSink[testEvent](tb)
+			var err error
+			resp, err = http.Get(sink.URL)
+			if err != nil {
+				t.Fatalf("request failed: %s", err)
+			}
+			resp.Body.Close()
+		})
+		HTTPStatus(t, resp, http.StatusMethodNotAllowed)
+		Equal(t, []string{"EventSink: unsupported request method GET"}, msgs)
+	})
+	t.Run("WaitEvents", func(t *testing.T) {
+		r := asserttest.NewRecorder(t)
+		var events []testEvent
+		r.Run(func(tb testing.TB) {
+			sink := NewEventSink[testEvent](tb)
+			postEvent(t, sink.URL, `{"name":"a"}`)
+			events = sink.WaitEvents(2, time.Millisecond)
+		})
+		Equal(t, []testEvent{{Name: "a"}}, events)
+		msgs := r.Messages()
+		Len(t, msgs, 1)
+		True(t, strings.HasPrefix(msgs[0], "Received 1 of 2 events within "), "%q", msgs[0])
+		True(t, strings.HasSuffix(msgs[0], ": [{a false}]"), "%q", msgs[0])
+	})
+}
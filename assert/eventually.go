@@ -0,0 +1,175 @@
+package assert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tprasadtp/pkg/race"
+)
+
+// Eventually asserts that cond returns true within timeout,
+// checking it every tick. Condition is checked immediately and
+// then on every tick. Timeout is scaled with [race.ScaleDuration],
+// so that it does not flake on slow, instrumented builds.
+//
+//	assert.Eventually(t, func() bool { return srv.Ready() }, time.Second, 10*time.Millisecond)
+//
+// Condition is run in a separate goroutine. If it blocks past timeout,
+// assertion fails without waiting for it to return. Thus, cond must not
+// call [testing.TB.FailNow] and must be safe to run after the test
+// has completed. Prefer [EventuallyContext], which allows cond to be
+// canceled.
+func Eventually(t testing.TB, cond func() bool, timeout, tick time.Duration, args ...any) bool {
+	t.Helper()
+	if tick <= 0 {
+		t.Errorf("Invalid tick %s, must be positive", tick)
+		return false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), race.ScaleDuration(timeout))
+	defer cancel()
+	elapsed, err := poll(ctx, tick, func(context.Context) error {
+		if cond() {
+			return nil
+		}
+		return errConditionFalse
+	}, false)
+	if err == nil {
+		return true
+	}
+	fallback := fmt.Sprintf("Condition was not satisfied within %s", elapsed)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// Never asserts that cond does not return true within duration,
+// checking it every tick. Duration is scaled with [race.ScaleDuration].
+// Like [Eventually], cond runs in a separate goroutine, and a blocked
+// cond is treated as not satisfied.
+func Never(t testing.TB, cond func() bool, duration, tick time.Duration, args ...any) bool {
+	t.Helper()
+	if tick <= 0 {
+		t.Errorf("Invalid tick %s, must be positive", tick)
+		return false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), race.ScaleDuration(duration))
+	defer cancel()
+	elapsed, err := poll(ctx, tick, func(context.Context) error {
+		if cond() {
+			return nil
+		}
+		return errConditionFalse
+	}, true)
+	if err == nil {
+		return true
+	}
+	fallback := fmt.Sprintf("Condition was satisfied after %s", elapsed)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// EventuallyContext asserts that cond returns nil error before ctx is done,
+// checking it every tick. If ctx is done before cond returns nil error,
+// last error returned by cond is reported. Context deadline is not scaled.
+// Like [Eventually], cond runs in a separate goroutine. It should return
+// when ctx is done, for example by passing ctx to HTTP requests.
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	assert.EventuallyContext(t, ctx, func(ctx context.Context) error {
+//		return srv.Ping(ctx)
+//	}, 10*time.Millisecond)
+func EventuallyContext(t testing.TB, ctx context.Context, cond func(context.Context) error,
+	tick time.Duration, args ...any,
+) bool {
+	t.Helper()
+	if tick <= 0 {
+		t.Errorf("Invalid tick %s, must be positive", tick)
+		return false
+	}
+	elapsed, err := poll(ctx, tick, cond, false)
+	if err == nil {
+		return true
+	}
+	fallback := fmt.Sprintf("Condition was not satisfied within %s: %s", elapsed, err)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// NeverContext asserts that cond never returns nil error before ctx is done,
+// checking it every tick. Context deadline is not scaled.
+func NeverContext(t testing.TB, ctx context.Context, cond func(context.Context) error,
+	tick time.Duration, args ...any,
+) bool {
+	t.Helper()
+	if tick <= 0 {
+		t.Errorf("Invalid tick %s, must be positive", tick)
+		return false
+	}
+	elapsed, err := poll(ctx, tick, cond, true)
+	if err == nil {
+		return true
+	}
+	fallback := fmt.Sprintf("Condition was satisfied after %s", elapsed)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+var (
+	errConditionFalse     = errors.New("condition returned false")
+	errConditionSatisfied = errors.New("condition was satisfied")
+)
+
+// poll calls cond every tick until ctx is done. Each call of cond runs in
+// a new goroutine and is abandoned if ctx is done before it returns.
+//
+// If never is false, returns nil error as soon as cond returns nil,
+// or returns the last error returned by cond when ctx is done.
+//
+// If never is true, returns an error as soon as cond returns nil,
+// or returns nil error when ctx is done.
+func poll(ctx context.Context, tick time.Duration, cond func(context.Context) error,
+	never bool,
+) (time.Duration, error) {
+	start := time.Now()
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	var lastErr error
+	result := make(chan error, 1)
+	for {
+		// Run cond in a goroutine, so that a blocked cond does not
+		// block past the deadline. Blocked cond is abandoned.
+		go func() {
+			result <- cond(ctx)
+		}()
+		select {
+		case lastErr = <-result:
+		case <-ctx.Done():
+			if never {
+				return time.Since(start).Round(time.Millisecond), nil
+			}
+			if lastErr == nil {
+				lastErr = fmt.Errorf("condition did not return: %w", ctx.Err())
+			}
+			return time.Since(start).Round(time.Millisecond), lastErr
+		}
+		if lastErr == nil {
+			if never {
+				return time.Since(start).Round(time.Millisecond), errConditionSatisfied
+			}
+			return time.Since(start).Round(time.Millisecond), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if never {
+				return time.Since(start).Round(time.Millisecond), nil
+			}
+			return time.Since(start).Round(time.Millisecond), lastErr
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package assert
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tprasadtp/pkg/race"
+)
+
+func TestEventually(t *testing.T) {
+	var counter atomic.Int32
+	Eventually(t, func() bool {
+		return counter.Add(1) > 3
+	}, time.Second, time.Millisecond)
+}
+
+func TestNever(t *testing.T) {
+	Never(t, func() bool {
+		return false
+	}, 20*time.Millisecond, time.Millisecond)
+}
+
+func TestEventuallyContext(t *testing.T) {
+	var counter atomic.Int32
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	EventuallyContext(t, ctx, func(context.Context) error {
+		if counter.Add(1) > 3 {
+			return nil
+		}
+		return errors.New("not ready")
+	}, time.Millisecond)
+}
+
+func TestNeverContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	NeverContext(t, ctx, func(context.Context) error {
+		return errors.New("not ready")
+	}, time.Millisecond)
+}
+
+func TestEventually_InvalidTick(t *testing.T) {
+	ctx := context.Background()
+	cond := func() bool { return true }
+	condCtx := func(context.Context) error { return nil }
+	for _, tick := range []time.Duration{0, -time.Millisecond} {
+		msgs := failureMessages(t, func(tb testing.TB) {
+			Eventually(tb, cond, time.Second, tick)
+			Never(tb, cond, time.Second, tick)
+			EventuallyContext(tb, ctx, condCtx, tick)
+			NeverContext(tb, ctx, condCtx, tick)
+		})
+		expect := "Invalid tick " + tick.String() + ", must be positive"
+		Equal(t, []string{expect, expect, expect, expect}, msgs)
+	}
+}
+
+func TestEventually_Blocked(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	cond := func() bool {
+		<-block
+		return true
+	}
+	start := time.Now()
+	msgs := failureMessages(t, func(tb testing.TB) {
+		Eventually(tb, cond, 20*time.Millisecond, time.Millisecond)
+		Never(tb, cond, 20*time.Millisecond, time.Millisecond)
+	})
+	Len(t, msgs, 1)
+	Less(t, time.Since(start), race.ScaleDuration(time.Second), "blocked cond must not block assertion")
+}
+
+func TestPoll(t *testing.T) {
+	t.Run("LastError", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		var counter atomic.Int32
+		errFirst := errors.New("first")
+		errLast := errors.New("last")
+		_, err := poll(ctx, time.Millisecond, func(context.Context) error {
+			if counter.Add(1) == 1 {
+				return errFirst
+			}
+			return errLast
+		}, false)
+		IsError(t, err, errLast)
+	})
+	t.Run("NeverSatisfied", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := poll(ctx, time.Millisecond, func(context.Context) error {
+			return nil
+		}, true)
+		IsError(t, err, errConditionSatisfied)
+	})
+	t.Run("ContextDone", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := poll(ctx, time.Hour, func(ctx context.Context) error {
+			return ctx.Err()
+		}, false)
+		IsError(t, err, context.Canceled)
+	})
+}
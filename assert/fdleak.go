@@ -0,0 +1,73 @@
+package assert
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// Descriptors with these targets are created lazily by the Go runtime
+// (network poller) and are never closed. They are ignored.
+var ignoredFDTargets = []string{
+	"anon_inode:[eventpoll]",
+	"anon_inode:[eventfd]",
+}
+
+// NoFDLeaks takes a snapshot of open file descriptors and registers
+// a cleanup function with t, which marks the test as failed if any
+// file descriptors opened during the test are still open when it completes.
+// Each leaked descriptor is reported along with its target, like
+// socket:[inode], pipe:[inode] or path of the file.
+//
+// This is only supported on Linux. On other platforms, this logs
+// a message and does nothing.
+//
+//	func TestListeners(t *testing.T) {
+//		assert.NoFDLeaks(t)
+//		...
+//	}
+//
+// As leaks are checked when test completes, there is no equivalent
+// of this in package require.
+func NoFDLeaks(t testing.TB) {
+	t.Helper()
+	before, err := openFDs()
+	if err != nil {
+		t.Logf("Skipping file descriptor leak detection: %s", err)
+		return
+	}
+	t.Cleanup(func() {
+		t.Helper()
+		after, err := openFDs()
+		if err != nil {
+			t.Errorf("Failed to list open file descriptors: %s", err)
+			return
+		}
+		if leaks := fdLeaks(before, after); leaks != "" {
+			t.Errorf("Found leaked file descriptor(s):\n%s", leaks)
+		}
+	})
+}
+
+// fdLeaks returns formatted list of descriptors which are present in after,
+// but are either not present in before or point to a different target.
+func fdLeaks(before, after map[int]string) string {
+	fds := make([]int, 0, len(after))
+	for fd, target := range after {
+		if contains(ignoredFDTargets, target) {
+			continue
+		}
+		if prev, ok := before[fd]; ok && prev == target {
+			continue
+		}
+		fds = append(fds, fd)
+	}
+	sort.Ints(fds)
+
+	var b strings.Builder
+	for _, fd := range fds {
+		fmt.Fprintf(&b, "  fd %d -> %s\n", fd, after[fd])
+	}
+	return b.String()
+}
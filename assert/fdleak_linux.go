@@ -0,0 +1,36 @@
+//go:build linux
+
+package assert
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// openFDs returns open file descriptors of the current process
+// mapped to their targets.
+func openFDs() (map[int]string, error) {
+	const dir = "/proc/self/fd"
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", dir, err)
+	}
+
+	rv := make(map[int]string, len(entries))
+	for _, entry := range entries {
+		fd, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		// Descriptor used to read the directory is already closed
+		// and fails to resolve. Ignore it and any others closed since.
+		target, err := os.Readlink(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		rv[fd] = target
+	}
+	return rv, nil
+}
@@ -0,0 +1,42 @@
+//go:build linux
+
+package assert
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tprasadtp/pkg/assert/asserttest"
+)
+
+func TestNoFDLeaks(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
+		NoFDLeaks(t)
+		f, err := os.Open(os.DevNull)
+		if err != nil {
+			t.Fatalf("failed to open: %s", err)
+		}
+		f.Close()
+	})
+	t.Run("Leaked", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "leaked.txt")
+		var leaked *os.File
+		r := asserttest.NewRecorder(t)
+		r.Run(func(tb testing.TB) {
+			NoFDLeaks(tb)
+			var err error
+			leaked, err = os.Create(path)
+			if err != nil {
+				tb.Fatalf("failed to create file: %s", err)
+			}
+		})
+		defer leaked.Close()
+
+		msgs := r.Messages()
+		if len(msgs) != 1 || !strings.Contains(msgs[0], path) {
+			t.Errorf("expected leaked file to be reported, got: %q", msgs)
+		}
+	})
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package assert
+
+import (
+	"errors"
+	"runtime"
+)
+
+// openFDs is not supported on this platform.
+func openFDs() (map[int]string, error) {
+	return nil, errors.New("not supported on " + runtime.GOOS)
+}
@@ -0,0 +1,22 @@
+package assert
+
+import (
+	"testing"
+)
+
+func TestFDLeaks(t *testing.T) {
+	before := map[int]string{
+		0: "/dev/null",
+		3: "anon_inode:[eventpoll]",
+		5: "socket:[100]",
+	}
+	after := map[int]string{
+		0: "/dev/null",
+		3: "anon_inode:[eventpoll]",
+		4: "anon_inode:[eventfd]",
+		5: "socket:[200]",
+		7: "/tmp/foo",
+	}
+	Equal(t, "  fd 5 -> socket:[200]\n  fd 7 -> /tmp/foo\n", fdLeaks(before, after))
+	Equal(t, "", fdLeaks(after, after))
+}
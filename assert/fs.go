@@ -0,0 +1,250 @@
+package assert
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// FileExists asserts that name exists in fsys and is a regular file.
+// Like all filesystem assertions, name is a slash separated path relative
+// to root of fsys. Use [os.DirFS] for real directories.
+//
+//	assert.FileExists(t, os.DirFS(dir), "man/test-cli.1")
+func FileExists(t testing.TB, fsys fs.FS, name string, args ...any) bool {
+	t.Helper()
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		fallback := fmt.Sprintf("File %s does not exist: %s", name, err)
+		t.Error(msgf(fallback, args...))
+		return false
+	}
+	if !info.Mode().IsRegular() {
+		fallback := fmt.Sprintf("File %s is not a regular file(mode=%s)", name, info.Mode())
+		t.Error(msgf(fallback, args...))
+		return false
+	}
+	return true
+}
+
+// NoFileExists asserts that name does not exist in fsys.
+func NoFileExists(t testing.TB, fsys fs.FS, name string, args ...any) bool {
+	t.Helper()
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		return true
+	}
+	fallback := fmt.Sprintf("File %s should not exist(mode=%s)", name, info.Mode())
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// DirContainsExactly asserts that fsys contains exactly the regular files
+// specified by names, including those in subdirectories. Use [fs.Sub]
+// to assert on a subdirectory.
+//
+//	assert.DirContainsExactly(t, os.DirFS(dir), []string{"test-cli.md", "test-cli-command1.md"})
+func DirContainsExactly(t testing.TB, fsys fs.FS, names []string, args ...any) bool {
+	t.Helper()
+	var files []string
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Failed to list files: %s", err)
+		return false
+	}
+
+	var b strings.Builder
+	for _, name := range sortedCopy(names) {
+		if !contains(files, name) {
+			fmt.Fprintf(&b, "missing file: %s\n", name)
+		}
+	}
+	for _, name := range files {
+		if !contains(names, name) {
+			fmt.Fprintf(&b, "unexpected file: %s\n", name)
+		}
+	}
+	if b.Len() == 0 {
+		return true
+	}
+	fallback := "Files do not match:\n" + b.String()
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// FileMode asserts that mode of name in fsys is mode, including type bits.
+//
+//	assert.FileMode(t, os.DirFS(dir), "bin", fs.ModeDir|0o755)
+func FileMode(t testing.TB, fsys fs.FS, name string, mode fs.FileMode, args ...any) bool {
+	t.Helper()
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		t.Errorf("Failed to stat %s: %s", name, err)
+		return false
+	}
+	if info.Mode() == mode {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected mode of %s to be %s, got %s", name, mode, info.Mode())
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// FileContains asserts that contents of file name in fsys contain substr.
+func FileContains(t testing.TB, fsys fs.FS, name, substr string, args ...any) bool {
+	t.Helper()
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		t.Errorf("Failed to read file: %s", err)
+		return false
+	}
+	if bytes.Contains(data, []byte(substr)) {
+		return true
+	}
+	fallback := fmt.Sprintf("File %s should contain %q", name, substr)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// FileNotContains asserts that contents of file name in fsys do not contain substr.
+func FileNotContains(t testing.TB, fsys fs.FS, name, substr string, args ...any) bool {
+	t.Helper()
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		t.Errorf("Failed to read file: %s", err)
+		return false
+	}
+	if !bytes.Contains(data, []byte(substr)) {
+		return true
+	}
+	fallback := fmt.Sprintf("File %s should not contain %q", name, substr)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// readGzipFile reads and decompresses gzip compressed file name in fsys.
+func readGzipFile(fsys fs.FS, name string) ([]byte, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gzip header of %s: %w", name, err)
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress %s: %w", name, err)
+	}
+	return data, nil
+}
+
+// FileGzipContains asserts that decompressed contents of gzip compressed
+// file name in fsys contain substr.
+//
+//	assert.FileGzipContains(t, os.DirFS(dir), "test-cli.1.gz", ".TH")
+func FileGzipContains(t testing.TB, fsys fs.FS, name, substr string, args ...any) bool {
+	t.Helper()
+	data, err := readGzipFile(fsys, name)
+	if err != nil {
+		t.Errorf("Failed to read gzip file: %s", err)
+		return false
+	}
+	if bytes.Contains(data, []byte(substr)) {
+		return true
+	}
+	fallback := fmt.Sprintf("Decompressed file %s should contain %q", name, substr)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// FileGzipNotContains asserts that decompressed contents of gzip compressed
+// file name in fsys do not contain substr.
+func FileGzipNotContains(t testing.TB, fsys fs.FS, name, substr string, args ...any) bool {
+	t.Helper()
+	data, err := readGzipFile(fsys, name)
+	if err != nil {
+		t.Errorf("Failed to read gzip file: %s", err)
+		return false
+	}
+	if !bytes.Contains(data, []byte(substr)) {
+		return true
+	}
+	fallback := fmt.Sprintf("Decompressed file %s should not contain %q", name, substr)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// TreeEqual asserts that expected and actual contain exactly the same regular
+// files with the same contents. Line endings are normalized before comparing.
+// On mismatch, missing and unexpected files along with a unified diff
+// of each differing file is reported. See [GoldenFS] to compare against
+// golden directory in testdata, which can be updated with -assert.update flag.
+//
+//	assert.TreeEqual(t, os.DirFS("testdata/expected"), os.DirFS(dir))
+func TreeEqual(t testing.TB, expected, actual fs.FS, args ...any) bool {
+	t.Helper()
+	want, err := readTree(expected)
+	if err != nil {
+		t.Errorf("Failed to read expected files: %s", err)
+		return false
+	}
+	got, err := readTree(actual)
+	if err != nil {
+		t.Errorf("Failed to read actual files: %s", err)
+		return false
+	}
+	diff := diffTrees("expected", want, got)
+	if diff == "" {
+		return true
+	}
+	fallback := "Files are not equal:\n" + diff
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// diffTrees returns missing and unexpected files, and unified diff of
+// each differing file. expectedRoot is used to name expected files in diffs.
+func diffTrees(expectedRoot string, expected, actual map[string][]byte) string {
+	var b strings.Builder
+	for _, file := range sortedKeys(expected) {
+		if _, ok := actual[file]; !ok {
+			fmt.Fprintf(&b, "missing file: %s\n", file)
+		}
+	}
+	for _, file := range sortedKeys(actual) {
+		want, ok := expected[file]
+		if !ok {
+			fmt.Fprintf(&b, "unexpected file: %s\n", file)
+			continue
+		}
+		want, got := normalizeNewlines(want), normalizeNewlines(actual[file])
+		if bytes.Equal(want, got) {
+			continue
+		}
+		b.WriteString(unifiedDiff(expectedRoot+"/"+file, file, string(want), string(got)))
+	}
+	return b.String()
+}
+
+func sortedCopy(s []string) []string {
+	rv := make([]string, len(s))
+	copy(rv, s)
+	sort.Strings(rv)
+	return rv
+}
@@ -0,0 +1,156 @@
+package assert
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+)
+
+func gzipData(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write([]byte(s)); err != nil {
+		t.Fatalf("failed to compress: %s", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("failed to compress: %s", err)
+	}
+	return buf.Bytes()
+}
+
+func testFS(t *testing.T) fstest.MapFS {
+	t.Helper()
+	return fstest.MapFS{
+		"a.txt":     {Data: []byte("foo bar\n"), Mode: 0o644},
+		"sub/b.txt": {Data: []byte("baz\n"), Mode: 0o600},
+		"c.gz":      {Data: gzipData(t, "compressed foo")},
+		"bin":       {Mode: fs.ModeDir | 0o755},
+	}
+}
+
+func TestFS(t *testing.T) {
+	fsys := testFS(t)
+	FileExists(t, fsys, "a.txt")
+	FileExists(t, fsys, "sub/b.txt")
+	NoFileExists(t, fsys, "d.txt")
+	DirContainsExactly(t, fsys, []string{"sub/b.txt", "c.gz", "a.txt"})
+	FileMode(t, fsys, "sub/b.txt", 0o600)
+	FileMode(t, fsys, "bin", fs.ModeDir|0o755)
+	FileContains(t, fsys, "a.txt", "bar")
+	FileNotContains(t, fsys, "a.txt", "baz")
+	FileGzipContains(t, fsys, "c.gz", "compressed")
+	FileGzipNotContains(t, fsys, "c.gz", "bar")
+	TreeEqual(t, fstest.MapFS{
+		"a.txt":     {Data: []byte("foo bar\r\n")},
+		"sub/b.txt": {Data: []byte("baz\n")},
+		"c.gz":      {Data: gzipData(t, "compressed foo")},
+	}, fsys)
+}
+
+func TestFS_FailureMessages(t *testing.T) {
+	fsys := testFS(t)
+	type testCase struct {
+		name   string
+		f      func(tb testing.TB)
+		expect string
+	}
+	tt := []testCase{
+		{
+			name:   "FileExists",
+			f:      func(tb testing.TB) { FileExists(tb, fsys, "d.txt") },
+			expect: "File d.txt does not exist: open d.txt: file does not exist",
+		},
+		{
+			name:   "FileExistsDir",
+			f:      func(tb testing.TB) { FileExists(tb, fsys, "sub") },
+			expect: "File sub is not a regular file(mode=dr-xr-xr-x)",
+		},
+		{
+			name:   "NoFileExists",
+			f:      func(tb testing.TB) { NoFileExists(tb, fsys, "a.txt") },
+			expect: "File a.txt should not exist(mode=-rw-r--r--)",
+		},
+		{
+			name:   "DirContainsExactly",
+			f:      func(tb testing.TB) { DirContainsExactly(tb, fsys, []string{"d.txt", "a.txt", "c.gz"}) },
+			expect: "Files do not match:\nmissing file: d.txt\nunexpected file: sub/b.txt\n",
+		},
+		{
+			name:   "FileMode",
+			f:      func(tb testing.TB) { FileMode(tb, fsys, "a.txt", 0o600) },
+			expect: "Expected mode of a.txt to be -rw-------, got -rw-r--r--",
+		},
+		{
+			name:   "FileContains",
+			f:      func(tb testing.TB) { FileContains(tb, fsys, "a.txt", "baz") },
+			expect: `File a.txt should contain "baz"`,
+		},
+		{
+			name:   "FileNotContains",
+			f:      func(tb testing.TB) { FileNotContains(tb, fsys, "a.txt", "foo") },
+			expect: `File a.txt should not contain "foo"`,
+		},
+		{
+			name:   "FileGzipContains",
+			f:      func(tb testing.TB) { FileGzipContains(tb, fsys, "c.gz", "bar") },
+			expect: `Decompressed file c.gz should contain "bar"`,
+		},
+		{
+			name:   "FileGzipNotContains",
+			f:      func(tb testing.TB) { FileGzipNotContains(tb, fsys, "c.gz", "foo") },
+			expect: `Decompressed file c.gz should not contain "foo"`,
+		},
+		{
+			name:   "FileGzipInvalid",
+			f:      func(tb testing.TB) { FileGzipContains(tb, fsys, "a.txt", "foo") },
+			expect: "Failed to read gzip file: failed to read gzip header of a.txt: unexpected EOF",
+		},
+		{
+			name: "TreeEqual",
+			f: func(tb testing.TB) {
+				TreeEqual(tb, fstest.MapFS{
+					"a.txt": {Data: []byte("foo\n")},
+					"e.txt": {},
+				}, fstest.MapFS{
+					"a.txt": {Data: []byte("bar\n")},
+					"f.txt": {},
+				})
+			},
+			expect: "Files are not equal:\n" +
+				"missing file: e.txt\n" +
+				"--- expected/a.txt\n" +
+				"+++ a.txt\n" +
+				"@@ -1,1 +1,1 @@\n" +
+				"-foo\n" +
+				"+bar\n" +
+				"unexpected file: f.txt\n",
+		},
+		{
+			name: "TreeEqualTrailingNewline",
+			f: func(tb testing.TB) {
+				TreeEqual(tb,
+					fstest.MapFS{"a.txt": {Data: []byte("x\n")}},
+					fstest.MapFS{"a.txt": {Data: []byte("x")}})
+			},
+			expect: "Files are not equal:\n" +
+				"--- expected/a.txt\n" +
+				"+++ a.txt\n" +
+				"@@ -1,1 +1,1 @@\n" +
+				"-x\n" +
+				"+x\n" +
+				"\\ No newline at end of file\n",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs := failureMessages(t, tc.f)
+			if len(msgs) != 1 {
+				t.Fatalf("expected 1 failure, got %d: %q", len(msgs), msgs)
+			}
+			Equal(t, tc.expect, msgs[0])
+		})
+	}
+}
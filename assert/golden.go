@@ -0,0 +1,203 @@
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+// Environment variable which can be used instead of -assert.update flag.
+const updateGoldenEnv = "GO_TEST_UPDATE_GOLDEN"
+
+// Directory in which golden files are stored.
+const goldenDir = "testdata"
+
+// Extension of golden files.
+const goldenExt = ".golden"
+
+// Flag is namespaced, so that it does not conflict with -update flag
+// defined by packages importing assert.
+//
+//nolint:gochecknoglobals // test flag.
+var updateGolden = flag.Bool("assert.update", false, "update golden files in testdata")
+
+// shouldUpdateGolden reports if golden files should be updated.
+func shouldUpdateGolden() bool {
+	if *updateGolden {
+		return true
+	}
+	v, _ := strconv.ParseBool(os.Getenv(updateGoldenEnv))
+	return v
+}
+
+// goldenPath returns path of golden file or directory name within
+// testdata directory. name must be a non-empty local path,
+// so that updating golden files never touches files outside
+// of testdata directory or the testdata directory itself.
+func goldenPath(name, ext string) (string, error) {
+	p := filepath.FromSlash(name)
+	if name == "" || !filepath.IsLocal(p) || filepath.Clean(p) == "." {
+		return "", fmt.Errorf("invalid golden name %q, must be a non-empty local path", name)
+	}
+	return filepath.Join(goldenDir, p+ext), nil
+}
+
+// normalizeNewlines converts CRLF line endings to LF. Bare CR characters,
+// like the ones used by progress bars, are preserved.
+func normalizeNewlines(b []byte) []byte {
+	return bytes.ReplaceAll(b, []byte("\r\n"), []byte("\n"))
+}
+
+// Golden asserts that got matches contents of golden file testdata/<name>.golden.
+// Line endings are normalized before comparing, so golden files checked out
+// with CRLF line endings work as expected. On mismatch, a unified diff
+// is reported.
+//
+// When tests are run with -assert.update flag or GO_TEST_UPDATE_GOLDEN=true,
+// golden file is updated with got instead. Flag is named -assert.update
+// and not -update, so that it does not conflict with -update flag defined
+// by packages importing assert.
+//
+//	go test ./... -assert.update
+func Golden[T ~string | ~[]byte](t testing.TB, name string, got T, args ...any) bool {
+	t.Helper()
+	path, err := goldenPath(name, goldenExt)
+	if err != nil {
+		t.Errorf("Invalid golden file: %s", err)
+		return false
+	}
+	if shouldUpdateGolden() {
+		if err := writeGolden(path, []byte(got)); err != nil {
+			t.Errorf("Failed to update golden file: %s", err)
+			return false
+		}
+		t.Logf("Updated golden file %s", path)
+		return true
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Errorf("Failed to read golden file(run with -assert.update to create it): %s", err)
+		return false
+	}
+
+	expected = normalizeNewlines(expected)
+	actual := normalizeNewlines([]byte(got))
+	if bytes.Equal(expected, actual) {
+		return true
+	}
+	diff := unifiedDiff(path, "got", string(expected), string(actual))
+	fallback := fmt.Sprintf("Output does not match golden file %s:\n%s", path, diff)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// GoldenFS asserts that all files in fsys match golden files in
+// directory testdata/<name>. Golden directory must contain exactly
+// the same files as fsys, with the same contents. Unlike [Golden],
+// files are not suffixed with .golden extension.
+//
+// This is useful to verify output of generators, which write multiple
+// files to a directory.
+//
+//	dir := t.TempDir()
+//	_ = cli.GenMarkdownTree(root, dir)
+//	assert.GoldenFS(t, "markdown", os.DirFS(dir))
+//
+// When tests are run with -assert.update flag or GO_TEST_UPDATE_GOLDEN=true,
+// golden directory is replaced with contents of fsys instead.
+func GoldenFS(t testing.TB, name string, fsys fs.FS, args ...any) bool {
+	t.Helper()
+	dir, err := goldenPath(name, "")
+	if err != nil {
+		t.Errorf("Invalid golden dir: %s", err)
+		return false
+	}
+
+	got, err := readTree(fsys)
+	if err != nil {
+		t.Errorf("Failed to read files: %s", err)
+		return false
+	}
+
+	if shouldUpdateGolden() {
+		if err = os.RemoveAll(dir); err != nil {
+			t.Errorf("Failed to remove golden dir: %s", err)
+			return false
+		}
+		for _, file := range sortedKeys(got) {
+			if err = writeGolden(filepath.Join(dir, filepath.FromSlash(file)), got[file]); err != nil {
+				t.Errorf("Failed to update golden file: %s", err)
+				return false
+			}
+		}
+		t.Logf("Updated golden dir %s", dir)
+		return true
+	}
+
+	expected, err := readTree(os.DirFS(dir))
+	if err != nil {
+		t.Errorf("Failed to read golden dir(run with -assert.update to create it): %s", err)
+		return false
+	}
+
+	diff := diffTrees(filepath.ToSlash(dir), expected, got)
+	if diff == "" {
+		return true
+	}
+	fallback := fmt.Sprintf("Files do not match golden dir %s:\n%s", dir, diff)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// readTree reads all regular files in fsys.
+func readTree(fsys fs.FS) (map[string][]byte, error) {
+	rv := make(map[string][]byte)
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+		rv[path] = data
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tree: %w", err)
+	}
+	return rv, nil
+}
+
+// writeGolden writes data to path, creating parent directories if required.
+func writeGolden(path string, data []byte) error {
+	err := os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil && !errors.Is(err, fs.ErrExist) {
+		return fmt.Errorf("failed to create dir: %w", err)
+	}
+	//nolint:gosec // golden files are not sensitive.
+	if err = os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,69 @@
+package assert
+
+import (
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestGolden(t *testing.T) {
+	Golden(t, "golden", "line1\nline2\n")
+	Golden(t, "golden", []byte("line1\r\nline2\r\n"))
+}
+
+func TestGoldenFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.txt":     &fstest.MapFile{Data: []byte("a\n")},
+		"sub/b.txt": &fstest.MapFile{Data: []byte("b\r\n")},
+	}
+	GoldenFS(t, "tree", fsys)
+}
+
+func TestGolden_TrailingNewline(t *testing.T) {
+	msgs := failureMessages(t, func(tb testing.TB) {
+		Golden(tb, "golden", "line1\nline2")
+	})
+	if len(msgs) != 1 || !strings.Contains(msgs[0], "\\ No newline at end of file") {
+		t.Errorf("expected missing newline to be reported, got: %q", msgs)
+	}
+}
+
+func TestNormalizeNewlines(t *testing.T) {
+	Equal(t, "a\nb\n", string(normalizeNewlines([]byte("a\r\nb\r\n"))))
+	Equal(t, "50%\r100%\n", string(normalizeNewlines([]byte("50%\r100%\r\n"))))
+}
+
+func TestGolden_InvalidName(t *testing.T) {
+	// Invalid names must be rejected before touching the filesystem,
+	// even in update mode.
+	t.Setenv(updateGoldenEnv, "true")
+	fsys := fstest.MapFS{"a.txt": &fstest.MapFile{Data: []byte("a\n")}}
+	for _, name := range []string{"", ".", "..", "../golden", "tree/../..", "/tmp/golden"} {
+		msgs := failureMessages(t, func(tb testing.TB) {
+			Golden(tb, name, "x")
+			GoldenFS(tb, name, fsys)
+		})
+		if len(msgs) != 2 || !strings.Contains(msgs[0], "Invalid golden file") ||
+			!strings.Contains(msgs[1], "Invalid golden dir") {
+			t.Errorf("name=%q => expected invalid name errors, got: %q", name, msgs)
+		}
+	}
+	if _, err := os.Stat(goldenDir + "/golden" + goldenExt); err != nil {
+		t.Errorf("golden files must not be modified: %s", err)
+	}
+}
+
+func TestReadTree(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.txt":     &fstest.MapFile{Data: []byte("a")},
+		"sub/b.txt": &fstest.MapFile{Data: []byte("b")},
+		"empty":     &fstest.MapFile{Mode: fs.ModeDir | 0o755},
+	}
+	files, err := readTree(fsys)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	Equal(t, map[string][]byte{"a.txt": []byte("a"), "sub/b.txt": []byte("b")}, files)
+}
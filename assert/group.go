@@ -0,0 +1,229 @@
+package assert
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var _ testing.TB = (*G)(nil)
+
+// G is a group of soft assertions, created by [Group].
+//
+// G implements [testing.TB], thus it can be passed to any assertion.
+// Failures reported to G are collected along with their locations,
+// instead of being reported immediately. Fatal and FailNow, like those
+// called by package require, stop the group, but not the test.
+type G struct {
+	// Parent test. This also ensures that unexported methods
+	// of testing.TB are implemented.
+	testing.TB
+
+	mu       sync.Mutex
+	failures []groupFailure
+	failed   bool
+	skipped  bool
+	skipMsg  string
+	helpers  map[string]bool
+}
+
+// groupFailure is a single failure collected by [G].
+type groupFailure struct {
+	location string
+	message  string
+}
+
+// Group runs f with a group of soft assertions, and reports all
+// collected failures as a single consolidated failure with their locations.
+// Unlike [testing.T.Fatal], Fatal and FailNow only stop f and remaining
+// assertions in the group are skipped, but test continues.
+// It returns true if none of the assertions in the group failed.
+//
+//	assert.Group(t, func(g *assert.G) {
+//		assert.Equal(g, "foo", v.Name)
+//		assert.Equal(g, 42, v.Size)
+//		assert.True(g, v.Enabled)
+//	})
+func Group(t testing.TB, f func(g *G)) bool {
+	t.Helper()
+	g := &G{TB: t}
+
+	// Run f in a new goroutine, so that FailNow and SkipNow
+	// can stop it with runtime.Goexit without stopping the test.
+	var (
+		panicked   bool
+		panicValue any
+	)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				panicked, panicValue = true, r
+			}
+		}()
+		f(g)
+	}()
+	<-done
+
+	if panicked {
+		panic(panicValue)
+	}
+
+	g.mu.Lock()
+	failures, failed, skipped, skipMsg := g.failures, g.failed, g.skipped, g.skipMsg
+	g.mu.Unlock()
+
+	switch {
+	case failed && len(failures) == 0:
+		// Fail or FailNow was called without reporting a failure.
+		t.Error("Group failed")
+	case failed:
+		var b strings.Builder
+		fmt.Fprintf(&b, "Group has %d failure(s):\n", len(failures))
+		for _, item := range failures {
+			lines := splitLines(item.message)
+			if len(lines) == 0 {
+				lines = []string{""}
+			}
+			fmt.Fprintf(&b, "  %s: %s\n", item.location, lines[0])
+			for _, line := range lines[1:] {
+				fmt.Fprintf(&b, "    %s\n", line)
+			}
+		}
+		t.Error(strings.TrimSuffix(b.String(), "\n"))
+	default:
+	}
+
+	if skipped {
+		t.Skip(skipMsg)
+	}
+	return !failed
+}
+
+// GroupRun is like [Group], but runs f as a subtest of t
+// with the given name. It returns true if subtest did not fail.
+func GroupRun(t *testing.T, name string, f func(g *G)) bool {
+	t.Helper()
+	return t.Run(name, func(t *testing.T) {
+		t.Helper()
+		Group(t, f)
+	})
+}
+
+// location returns file:line of the first caller of Error, Errorf,
+// Fatal or Fatalf, which is not marked as a helper.
+func (g *G) location() string {
+	// Skip runtime.Callers, location, record and the calling method of G.
+	var pcs [32]uintptr
+	n := runtime.Callers(4, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for {
+		frame, more := frames.Next()
+		if !g.helpers[frame.Function] {
+			return fmt.Sprintf("%s:%d", filepath.Base(frame.File), frame.Line)
+		}
+		if !more {
+			return "???"
+		}
+	}
+}
+
+func (g *G) record(msg string) {
+	location := g.location()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.failed = true
+	g.failures = append(g.failures, groupFailure{location: location, message: msg})
+}
+
+// Helper marks the calling function as a helper function.
+// Helper functions are skipped when recording failure locations.
+func (g *G) Helper() {
+	var pc [1]uintptr
+	if runtime.Callers(2, pc[:]) == 0 {
+		return
+	}
+	frame, _ := runtime.CallersFrames(pc[:]).Next()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.helpers == nil {
+		g.helpers = make(map[string]bool)
+	}
+	g.helpers[frame.Function] = true
+}
+
+// Error records a failure with its location.
+func (g *G) Error(args ...any) {
+	g.record(strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+}
+
+// Errorf records a failure with its location.
+func (g *G) Errorf(format string, args ...any) {
+	g.record(fmt.Sprintf(format, args...))
+}
+
+// Fail marks the group as failed.
+func (g *G) Fail() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.failed = true
+}
+
+// FailNow marks the group as failed and stops the group.
+func (g *G) FailNow() {
+	g.Fail()
+	runtime.Goexit()
+}
+
+// Failed reports whether the group has failed.
+func (g *G) Failed() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.failed
+}
+
+// Fatal records a failure with its location and stops the group.
+func (g *G) Fatal(args ...any) {
+	g.record(strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+	runtime.Goexit()
+}
+
+// Fatalf records a failure with its location and stops the group.
+func (g *G) Fatalf(format string, args ...any) {
+	g.record(fmt.Sprintf(format, args...))
+	runtime.Goexit()
+}
+
+// Skip stops the group and skips the test, once the group completes.
+// Failures recorded before Skip is called are still reported.
+func (g *G) Skip(args ...any) {
+	g.mu.Lock()
+	g.skipped = true
+	g.skipMsg = strings.TrimSuffix(fmt.Sprintln(args...), "\n")
+	g.mu.Unlock()
+	runtime.Goexit()
+}
+
+// Skipf is like Skip, but formats the message.
+func (g *G) Skipf(format string, args ...any) {
+	g.Skip(fmt.Sprintf(format, args...))
+}
+
+// SkipNow is like Skip, but without a message.
+func (g *G) SkipNow() {
+	g.Skip()
+}
+
+// Skipped reports whether the group was skipped.
+func (g *G) Skipped() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.skipped
+}
@@ -0,0 +1,99 @@
+package assert
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/tprasadtp/pkg/assert/asserttest"
+)
+
+// callerLine returns line number of the caller.
+func callerLine() int {
+	_, _, line, _ := runtime.Caller(1)
+	return line
+}
+
+func assertPositive(tb testing.TB, v int) {
+	tb.Helper()
+	Greater(tb, v, 0)
+}
+
+func TestGroup(t *testing.T) {
+	ok := Group(t, func(g *G) {
+		Equal(g, "foo", "foo")
+		True(g, true)
+		assertPositive(g, 1)
+	})
+	True(t, ok)
+}
+
+func TestGroup_FailureMessage(t *testing.T) {
+	var lines []int
+	var reached bool
+	msgs := failureMessages(t, func(tb testing.TB) {
+		Group(tb, func(g *G) {
+			lines = append(lines, callerLine()+1)
+			Equal(g, "foo", "bar")
+			lines = append(lines, callerLine()+1)
+			assertPositive(g, -1)
+			lines = append(lines, callerLine()+1)
+			g.Fatalf("stop %d", 1)
+			reached = true
+		})
+	})
+	if len(msgs) != 1 {
+		t.Fatalf("expected 1 failure, got %d: %q", len(msgs), msgs)
+	}
+	False(t, reached, "Fatal must stop the group")
+	expect := strings.Join([]string{
+		"Group has 3 failure(s):",
+		fmt.Sprintf("  group_test.go:%d: Values are not equal:", lines[0]),
+		`      (value): expected "foo", got "bar"`,
+		fmt.Sprintf("  group_test.go:%d: Expected -1 to be greater than 0", lines[1]),
+		fmt.Sprintf("  group_test.go:%d: stop 1", lines[2]),
+	}, "\n")
+	Equal(t, expect, msgs[0])
+}
+
+func TestGroup_ContinuesTest(t *testing.T) {
+	r := asserttest.NewRecorder(t)
+	var reached bool
+	r.Run(func(tb testing.TB) {
+		Group(tb, func(g *G) {
+			g.FailNow()
+		})
+		reached = true
+	})
+	True(t, r.Failed(), "Group must fail")
+	True(t, reached, "Group must not stop the test")
+	Equal(t, []string{"Group failed"}, r.Messages())
+}
+
+func TestGroup_Skip(t *testing.T) {
+	r := asserttest.NewRecorder(t)
+	r.Run(func(tb testing.TB) {
+		Group(tb, func(g *G) {
+			g.Skipf("skip %s", "reason")
+		})
+	})
+	True(t, r.Skipped(), "Group must skip the test")
+	False(t, r.Failed())
+	Equal(t, []string{"skip reason"}, r.Messages(asserttest.KindSkip))
+}
+
+func TestGroup_Panic(t *testing.T) {
+	PanicsWithValue(t, "boom", func() {
+		Group(t, func(*G) {
+			panic("boom")
+		})
+	})
+}
+
+func TestGroupRun(t *testing.T) {
+	ok := GroupRun(t, "subtest", func(g *G) {
+		Equal(g, g.Name(), t.Name()+"/subtest")
+	})
+	True(t, ok)
+}
@@ -0,0 +1,181 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// Maximum length of response body included in failure messages.
+const maxHTTPBodyLen = 512
+
+// HTTPResponse is a constraint for HTTP responses supported by HTTP assertions.
+//
+// Use [ServeHTTP] to get a [*httptest.ResponseRecorder] from an [http.Handler].
+// Bodies of [*http.Response] are read fully and replaced, so that multiple
+// assertions can be made on the same response.
+type HTTPResponse interface {
+	*httptest.ResponseRecorder | *http.Response
+}
+
+// ServeHTTP serves req with handler h and returns the recorded response.
+//
+//	rr := assert.ServeHTTP(handler, httptest.NewRequest(http.MethodGet, "/version", nil))
+//	assert.HTTPStatus(t, rr, http.StatusOK)
+//	assert.HTTPBodyContains(t, rr, "v1.2.3")
+func ServeHTTP(h http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	return rr
+}
+
+// httpResult returns status code, headers and body of resp.
+func httpResult[R HTTPResponse](resp R) (int, http.Header, []byte, error) {
+	switch v := any(resp).(type) {
+	case *httptest.ResponseRecorder:
+		if v == nil {
+			return 0, nil, nil, errors.New("response is nil")
+		}
+		// Result returns a snapshot of headers at the time of first write.
+		result := v.Result()
+		defer result.Body.Close()
+		return v.Code, result.Header, v.Body.Bytes(), nil
+	case *http.Response:
+		if v == nil {
+			return 0, nil, nil, errors.New("response is nil")
+		}
+		if v.Body == nil || v.Body == http.NoBody {
+			return v.StatusCode, v.Header, nil, nil
+		}
+		body, err := io.ReadAll(v.Body)
+		_ = v.Body.Close()
+		v.Body = io.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			return 0, nil, nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return v.StatusCode, v.Header, body, nil
+	default:
+		return 0, nil, nil, fmt.Errorf("unsupported response type %T", resp)
+	}
+}
+
+// formatBody formats response body for failure messages, truncating it if required.
+func formatBody(body []byte) string {
+	if len(body) > maxHTTPBodyLen {
+		return fmt.Sprintf("%q...(%d bytes)", body[:maxHTTPBodyLen], len(body))
+	}
+	return fmt.Sprintf("%q", body)
+}
+
+// formatStatus formats status code along with its text.
+func formatStatus(code int) string {
+	if text := http.StatusText(code); text != "" {
+		return fmt.Sprintf("%d %s", code, text)
+	}
+	return fmt.Sprintf("%d", code)
+}
+
+// HTTPStatus asserts that response status code is code.
+// On failure, response body is included in the message.
+//
+//	assert.HTTPStatus(t, rr, http.StatusOK)
+func HTTPStatus[R HTTPResponse](t testing.TB, resp R, code int, args ...any) bool {
+	t.Helper()
+	status, _, body, err := httpResult(resp)
+	if err != nil {
+		t.Errorf("Invalid HTTP response: %s", err)
+		return false
+	}
+	if status == code {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected status %s, got %s, body: %s",
+		formatStatus(code), formatStatus(status), formatBody(body))
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// HTTPHeader asserts that response header key has value. If header has multiple
+// values, first value is compared.
+//
+//	assert.HTTPHeader(t, rr, "Content-Type", "application/json")
+func HTTPHeader[R HTTPResponse](t testing.TB, resp R, key, value string, args ...any) bool {
+	t.Helper()
+	_, header, _, err := httpResult(resp)
+	if err != nil {
+		t.Errorf("Invalid HTTP response: %s", err)
+		return false
+	}
+	values := header.Values(key)
+	if len(values) > 0 && values[0] == value {
+		return true
+	}
+	got := "<missing>"
+	if len(values) > 0 {
+		got = fmt.Sprintf("%q", values[0])
+	}
+	fallback := fmt.Sprintf("Expected header %s to be %q, got %s",
+		http.CanonicalHeaderKey(key), value, got)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// HTTPBodyJSON asserts that response body is a JSON document semantically
+// equal to expected. Like [JSONPath], expected is marshaled to JSON before
+// comparing. Use [json.RawMessage] to compare against a raw JSON document.
+//
+//	assert.HTTPBodyJSON(t, rr, map[string]any{"version": "v1.2.3"})
+func HTTPBodyJSON[R HTTPResponse](t testing.TB, resp R, expected any, args ...any) bool {
+	t.Helper()
+	_, _, body, err := httpResult(resp)
+	if err != nil {
+		t.Errorf("Invalid HTTP response: %s", err)
+		return false
+	}
+	actual, err := decodeJSON(body)
+	if err != nil {
+		t.Errorf("Response body is not valid JSON: %s, body: %s", err, formatBody(body))
+		return false
+	}
+	b, err := json.Marshal(expected)
+	if err != nil {
+		t.Errorf("Failed to marshal expected value: %s", err)
+		return false
+	}
+	want, err := decodeJSON(b)
+	if err != nil {
+		t.Errorf("Failed to decode expected value: %s", err)
+		return false
+	}
+
+	var diffs []difference
+	compareJSON("$", want, actual, &diffs)
+	if len(diffs) == 0 {
+		return true
+	}
+	fallback := "Response body JSON is not equal:\n" + formatDiffs(diffs)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// HTTPBodyContains asserts that response body contains substr.
+func HTTPBodyContains[R HTTPResponse](t testing.TB, resp R, substr string, args ...any) bool {
+	t.Helper()
+	_, _, body, err := httpResult(resp)
+	if err != nil {
+		t.Errorf("Invalid HTTP response: %s", err)
+		return false
+	}
+	if strings.Contains(string(body), substr) {
+		return true
+	}
+	fallback := fmt.Sprintf("Response body should contain %q, body: %s", substr, formatBody(body))
+	t.Error(msgf(fallback, args...))
+	return false
+}
@@ -0,0 +1,120 @@
+package assert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func testHTTPHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/version":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, `{"version": "v1.2.3", "commit": ""}`)
+		case "/large":
+			_, _ = io.WriteString(w, strings.Repeat("a", maxHTTPBodyLen+1))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func TestHTTP(t *testing.T) {
+	rr := ServeHTTP(testHTTPHandler(), httptest.NewRequest(http.MethodGet, "/version", nil))
+	HTTPStatus(t, rr, http.StatusOK)
+	HTTPHeader(t, rr, "content-type", "application/json")
+	HTTPBodyContains(t, rr, "v1.2.3")
+	HTTPBodyJSON(t, rr, map[string]any{"version": "v1.2.3", "commit": ""})
+	HTTPBodyJSON(t, rr, json.RawMessage(`{"commit":"","version":"v1.2.3"}`))
+	HTTPBodyJSON(t, rr, struct {
+		Version string `json:"version"`
+		Commit  string `json:"commit"`
+	}{Version: "v1.2.3"})
+}
+
+func TestHTTP_Response(t *testing.T) {
+	server := httptest.NewServer(testHTTPHandler())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/version")
+	if err != nil {
+		t.Fatalf("request failed: %s", err)
+	}
+	defer resp.Body.Close()
+
+	// Body can be used by multiple assertions.
+	HTTPStatus(t, resp, http.StatusOK)
+	HTTPBodyContains(t, resp, "v1.2.3")
+	HTTPBodyJSON(t, resp, map[string]any{"version": "v1.2.3", "commit": ""})
+}
+
+func TestHTTP_FailureMessages(t *testing.T) {
+	handler := testHTTPHandler()
+	get := func(path string) *httptest.ResponseRecorder {
+		return ServeHTTP(handler, httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	type testCase struct {
+		name   string
+		f      func(tb testing.TB)
+		expect string
+	}
+	tt := []testCase{
+		{
+			name:   "HTTPStatus",
+			f:      func(tb testing.TB) { HTTPStatus(tb, get("/foo"), http.StatusOK) },
+			expect: `Expected status 200 OK, got 404 Not Found, body: "404 page not found\n"`,
+		},
+		{
+			name: "HTTPStatusLargeBody",
+			f:    func(tb testing.TB) { HTTPStatus(tb, get("/large"), http.StatusNoContent) },
+			expect: fmt.Sprintf("Expected status 204 No Content, got 200 OK, body: %q...(%d bytes)",
+				strings.Repeat("a", maxHTTPBodyLen), maxHTTPBodyLen+1),
+		},
+		{
+			name:   "HTTPStatusNil",
+			f:      func(tb testing.TB) { HTTPStatus(tb, (*http.Response)(nil), http.StatusOK) },
+			expect: "Invalid HTTP response: response is nil",
+		},
+		{
+			name:   "HTTPHeader",
+			f:      func(tb testing.TB) { HTTPHeader(tb, get("/version"), "content-type", "text/plain") },
+			expect: `Expected header Content-Type to be "text/plain", got "application/json"`,
+		},
+		{
+			name:   "HTTPHeaderMissing",
+			f:      func(tb testing.TB) { HTTPHeader(tb, get("/version"), "X-Foo", "bar") },
+			expect: `Expected header X-Foo to be "bar", got <missing>`,
+		},
+		{
+			name: "HTTPBodyJSON",
+			f: func(tb testing.TB) {
+				HTTPBodyJSON(tb, get("/version"), map[string]any{"version": "v1.2.4", "commit": ""})
+			},
+			expect: "Response body JSON is not equal:\n  $.version: expected \"v1.2.4\", got \"v1.2.3\"\n",
+		},
+		{
+			name:   "HTTPBodyJSONInvalid",
+			f:      func(tb testing.TB) { HTTPBodyJSON(tb, get("/foo"), nil) },
+			expect: "Response body is not valid JSON: unexpected data after top-level value, body: \"404 page not found\\n\"",
+		},
+		{
+			name:   "HTTPBodyContains",
+			f:      func(tb testing.TB) { HTTPBodyContains(tb, get("/foo"), "bar") },
+			expect: `Response body should contain "bar", body: "404 page not found\n"`,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs := failureMessages(t, tc.f)
+			if len(msgs) != 1 {
+				t.Fatalf("expected 1 failure, got %d: %q", len(msgs), msgs)
+			}
+			Equal(t, tc.expect, msgs[0])
+		})
+	}
+}
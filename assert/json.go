@@ -0,0 +1,276 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// JSONEq asserts that expected and actual are semantically equal JSON documents.
+// Order of object keys, whitespace and number formatting (1 vs 1.0) are ignored.
+// On failure, differences are reported with their paths.
+//
+//	$.goVersion: expected "go1.21.0", got "go1.21.1"
+func JSONEq[T ~string | ~[]byte](t testing.TB, expected, actual T, args ...any) bool {
+	t.Helper()
+	x, err := decodeJSON([]byte(expected))
+	if err != nil {
+		t.Errorf("Expected value is not valid JSON: %s", err)
+		return false
+	}
+	y, err := decodeJSON([]byte(actual))
+	if err != nil {
+		t.Errorf("Actual value is not valid JSON: %s", err)
+		return false
+	}
+
+	var diffs []difference
+	compareJSON("$", x, y, &diffs)
+	if len(diffs) == 0 {
+		return true
+	}
+	fallback := "JSON documents are not equal:\n" + formatDiffs(diffs)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// JSONPath asserts that value at path in JSON document data is equal to expected.
+// Expected value is marshaled to JSON before comparing, thus it can be any value
+// which can be marshaled to JSON, including structs with json tags.
+//
+// Path supports a subset of JSONPath syntax, which selects a single value.
+//
+//	$.version
+//	$.items[0].name
+//	$["key with spaces"]
+//
+// Example:
+//
+//	assert.JSONPath(t, output, "$.version", "v1.2.3")
+func JSONPath[T ~string | ~[]byte](t testing.TB, data T, path string, expected any, args ...any) bool {
+	t.Helper()
+	doc, err := decodeJSON([]byte(data))
+	if err != nil {
+		t.Errorf("Value is not valid JSON: %s", err)
+		return false
+	}
+
+	actual, err := lookupJSONPath(doc, path)
+	if err != nil {
+		fallback := fmt.Sprintf("JSONPath %s: %s", path, err)
+		t.Error(msgf(fallback, args...))
+		return false
+	}
+
+	b, err := json.Marshal(expected)
+	if err != nil {
+		t.Errorf("Failed to marshal expected value: %s", err)
+		return false
+	}
+	want, err := decodeJSON(b)
+	if err != nil {
+		t.Errorf("Failed to decode expected value: %s", err)
+		return false
+	}
+
+	var diffs []difference
+	compareJSON(path, want, actual, &diffs)
+	if len(diffs) == 0 {
+		return true
+	}
+	fallback := "JSON values are not equal:\n" + formatDiffs(diffs)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// decodeJSON decodes a single JSON value, preserving numbers as [json.Number].
+func decodeJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON: %w", err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after top-level value")
+	}
+	return v, nil
+}
+
+// formatJSON formats a decoded JSON value for failure messages.
+func formatJSON(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+// jsonNumberEqual compares numbers by value, so that 1 and 1.0 are equal.
+func jsonNumberEqual(x, y json.Number) bool {
+	if x == y {
+		return true
+	}
+	a, _, err := big.ParseFloat(string(x), 10, 256, big.ToNearestEven)
+	if err != nil {
+		return false
+	}
+	b, _, err := big.ParseFloat(string(y), 10, 256, big.ToNearestEven)
+	if err != nil {
+		return false
+	}
+	return a.Cmp(b) == 0
+}
+
+// Object keys, which can be used with dot notation.
+var jsonIdentRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$-]*$`)
+
+func jsonKeyPath(path, key string) string {
+	if jsonIdentRegex.MatchString(key) {
+		return path + "." + key
+	}
+	return path + "[" + strconv.Quote(key) + "]"
+}
+
+// compareJSON compares decoded JSON values x and y.
+func compareJSON(path string, x, y any, diffs *[]difference) {
+	report := func() {
+		*diffs = append(*diffs, difference{
+			path:     path,
+			expected: formatJSON(x),
+			actual:   formatJSON(y),
+		})
+	}
+
+	switch xv := x.(type) {
+	case map[string]any:
+		yv, ok := y.(map[string]any)
+		if !ok {
+			report()
+			return
+		}
+		for _, k := range sortedKeys(xv) {
+			if _, ok := yv[k]; !ok {
+				*diffs = append(*diffs, difference{
+					path:     jsonKeyPath(path, k),
+					expected: formatJSON(xv[k]),
+					actual:   "<missing>",
+				})
+				continue
+			}
+			compareJSON(jsonKeyPath(path, k), xv[k], yv[k], diffs)
+		}
+		for _, k := range sortedKeys(yv) {
+			if _, ok := xv[k]; !ok {
+				*diffs = append(*diffs, difference{
+					path:     jsonKeyPath(path, k),
+					expected: "<missing>",
+					actual:   formatJSON(yv[k]),
+				})
+			}
+		}
+	case []any:
+		yv, ok := y.([]any)
+		if !ok {
+			report()
+			return
+		}
+		for i := 0; i < max(len(xv), len(yv)); i++ {
+			p := path + "[" + strconv.Itoa(i) + "]"
+			switch {
+			case i >= len(yv):
+				*diffs = append(*diffs, difference{path: p, expected: formatJSON(xv[i]), actual: "<missing>"})
+			case i >= len(xv):
+				*diffs = append(*diffs, difference{path: p, expected: "<missing>", actual: formatJSON(yv[i])})
+			default:
+				compareJSON(p, xv[i], yv[i], diffs)
+			}
+		}
+	case json.Number:
+		yv, ok := y.(json.Number)
+		if !ok || !jsonNumberEqual(xv, yv) {
+			report()
+		}
+	default:
+		// Strings, booleans and null.
+		if x != y {
+			report()
+		}
+	}
+}
+
+// lookupJSONPath returns value at path in decoded JSON document doc.
+//
+//nolint:gocognit // simple parser.
+func lookupJSONPath(doc any, path string) (any, error) {
+	rest, ok := strings.CutPrefix(path, "$")
+	if !ok {
+		return nil, errors.New("path must start with $")
+	}
+
+	current := doc
+	traversed := "$"
+	for rest != "" {
+		switch {
+		case rest[0] == '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			key := rest[1 : end+1]
+			if key == "" {
+				return nil, fmt.Errorf("empty key after %s", traversed)
+			}
+			rest = rest[end+1:]
+			obj, ok := current.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s is not an object", traversed)
+			}
+			if current, ok = obj[key]; !ok {
+				return nil, fmt.Errorf("key %q not found in %s", key, traversed)
+			}
+			traversed = jsonKeyPath(traversed, key)
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated [ after %s", traversed)
+			}
+			selector := rest[1:end]
+			rest = rest[end+1:]
+			if key, err := strconv.Unquote(selector); err == nil {
+				obj, ok := current.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("%s is not an object", traversed)
+				}
+				if current, ok = obj[key]; !ok {
+					return nil, fmt.Errorf("key %q not found in %s", key, traversed)
+				}
+				traversed = jsonKeyPath(traversed, key)
+				continue
+			}
+			idx, err := strconv.Atoi(selector)
+			if err != nil {
+				return nil, fmt.Errorf("invalid selector [%s] after %s", selector, traversed)
+			}
+			arr, ok := current.([]any)
+			if !ok {
+				return nil, fmt.Errorf("%s is not an array", traversed)
+			}
+			if idx < 0 || idx >= len(arr) {
+				return nil, fmt.Errorf("index %d out of range in %s(len=%d)", idx, traversed, len(arr))
+			}
+			current = arr[idx]
+			traversed += "[" + strconv.Itoa(idx) + "]"
+		default:
+			return nil, fmt.Errorf("unexpected %q after %s", rest[0], traversed)
+		}
+	}
+	return current, nil
+}
@@ -0,0 +1,110 @@
+package assert
+
+import (
+	"testing"
+)
+
+func TestJSONEq(t *testing.T) {
+	JSONEq(t, `{"a": 1, "b": [1, 2.0, {"c": null}]}`, `{"b":[1,2,{"c":null}],"a":1.0}`)
+	JSONEq(t, []byte(`"foo"`), []byte(` "foo" `))
+}
+
+func TestCompareJSON(t *testing.T) {
+	type testCase struct {
+		name     string
+		expected string
+		actual   string
+		diffs    []string
+	}
+	tt := []testCase{
+		{
+			name:     "equal",
+			expected: `{"version": "v1.0.0", "goVersion": "go1.21.0"}`,
+			actual:   `{"goVersion": "go1.21.0", "version": "v1.0.0"}`,
+		},
+		{
+			name:     "value",
+			expected: `{"version": "v1.0.0", "goVersion": "go1.21.0"}`,
+			actual:   `{"version": "v1.0.0", "goVersion": "go1.21.1"}`,
+			diffs:    []string{`$.goVersion: expected "go1.21.0", got "go1.21.1"`},
+		},
+		{
+			name:     "missing-and-extra-keys",
+			expected: `{"a": 1, "key with space": true}`,
+			actual:   `{"b": 1}`,
+			diffs: []string{
+				`$.a: expected 1, got <missing>`,
+				`$["key with space"]: expected true, got <missing>`,
+				`$.b: expected <missing>, got 1`,
+			},
+		},
+		{
+			name:     "array",
+			expected: `{"items": [1, 2]}`,
+			actual:   `{"items": [1, 3, 4]}`,
+			diffs: []string{
+				`$.items[1]: expected 2, got 3`,
+				`$.items[2]: expected <missing>, got 4`,
+			},
+		},
+		{
+			name:     "type",
+			expected: `{"a": "1"}`,
+			actual:   `{"a": 1}`,
+			diffs:    []string{`$.a: expected "1", got 1`},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			x, err := decodeJSON([]byte(tc.expected))
+			NoErrors(t, err)
+			y, err := decodeJSON([]byte(tc.actual))
+			NoErrors(t, err)
+			var diffs []difference
+			compareJSON("$", x, y, &diffs)
+			got := splitLines(formatDiffs(diffs))
+			if len(got) != len(tc.diffs) {
+				t.Fatalf("expected %d diffs, got %d:\n%s", len(tc.diffs), len(got), formatDiffs(diffs))
+			}
+			for i := range got {
+				Equal(t, "  "+tc.diffs[i], got[i])
+			}
+		})
+	}
+}
+
+func TestDecodeJSON_Invalid(t *testing.T) {
+	for _, input := range []string{``, `{`, `{"a": 1} {"b": 2}`, `[1, 2`} {
+		if _, err := decodeJSON([]byte(input)); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
+
+func TestJSONPath(t *testing.T) {
+	doc := `{"version": "v1.2.3", "items": [{"name": "a"}, {"name": "b"}], "a key": {"n": 1}}`
+	JSONPath(t, doc, "$.version", "v1.2.3")
+	JSONPath(t, doc, "$.items[1].name", "b")
+	JSONPath(t, doc, `$["a key"].n`, 1)
+	JSONPath(t, doc, `$["a key"]`, map[string]int{"n": 1})
+	JSONPath(t, doc, "$.items[0]", struct {
+		Name string `json:"name"`
+	}{Name: "a"})
+
+	for _, path := range []string{
+		"version",
+		"$.missing",
+		"$.items[5]",
+		"$.items.name",
+		"$.version[0]",
+		"$.items[x]",
+		"$.items[0",
+		"$..version",
+	} {
+		d, err := decodeJSON([]byte(doc))
+		NoErrors(t, err)
+		if _, err = lookupJSONPath(d, path); err == nil {
+			t.Errorf("expected error for path %s", path)
+		}
+	}
+}
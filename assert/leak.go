@@ -1,14 +1,302 @@
 package assert
 
 import (
+	"bytes"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
-// NoLeaks marks the given test as failed if any extra goroutines are
-// found. This is a helper method to make it easier to integrate in
-// tests by doing:
+// Default time to wait for goroutines to exit.
+const defaultLeakTimeout = time.Second
+
+// Maximum delay between goroutine snapshots.
+const maxLeakBackoff = 100 * time.Millisecond
+
+// Goroutines with these entry functions are always ignored. These are either
+// started by the runtime, testing package or by the standard library and
+// are expected to be running for the lifetime of the test binary.
+var defaultIgnoredEntries = []string{
+	"main.main",
+	"testing.tRunner",
+	"testing.(*T).Run",
+	"testing.runFuzzing",
+	"os/signal.loop",
+	"runtime.ensureSigM",
+	"runtime/trace.Start.func1",
+}
+
+// LeakOption configures goroutine leak detection.
+type LeakOption func(*leakOptions)
+
+type leakOptions struct {
+	timeout      time.Duration
+	topFunctions []string
+	createdBy    []string
+	ignoreIDs    map[uint64]bool
+}
+
+// IgnoreTopFunction ignores goroutines whose topmost function
+// (the function the goroutine is currently executing) is f.
+//
+//	defer assert.NoLeaks(t, assert.IgnoreTopFunction("internal/poll.runtime_pollWait"))
+func IgnoreTopFunction(f string) LeakOption {
+	return func(o *leakOptions) {
+		o.topFunctions = append(o.topFunctions, f)
+	}
+}
+
+// IgnoreCreatedBy ignores goroutines which were created by function f.
+func IgnoreCreatedBy(f string) LeakOption {
+	return func(o *leakOptions) {
+		o.createdBy = append(o.createdBy, f)
+	}
+}
+
+// IgnoreCurrent ignores all goroutines which are running when
+// this option is created. [NoLeaks] always ignores goroutines running
+// when it is called. This is useful with [VerifyTestMain], to ignore
+// goroutines started by package initialization.
 //
-//	defer assert.NoLeaks(t)
-func NoLeaks(t testing.TB) {
+//	func TestMain(m *testing.M) {
+//		assert.VerifyTestMain(m, assert.IgnoreCurrent())
+//	}
+func IgnoreCurrent() LeakOption {
+	ids := make(map[uint64]bool)
+	for _, g := range goroutines() {
+		ids[g.id] = true
+	}
+	return func(o *leakOptions) {
+		for id := range ids {
+			o.ignoreIDs[id] = true
+		}
+	}
+}
+
+// LeakTimeout sets the maximum time to wait for goroutines to exit
+// before reporting them as leaked. Default is 1s.
+func LeakTimeout(d time.Duration) LeakOption {
+	return func(o *leakOptions) {
+		o.timeout = d
+	}
+}
+
+// goroutine is a single goroutine parsed from [runtime.Stack] output.
+type goroutine struct {
+	id        uint64
+	top       string
+	entry     string
+	createdBy string
+	stack     string
+}
+
+// NoLeaks takes a snapshot of running goroutines and marks the given test
+// as failed, if any goroutines other than the ones in the snapshot are
+// running after the test and all its subtests have completed.
+// Call it at the start of the test.
+//
+//	func TestServer(t *testing.T) {
+//		assert.NoLeaks(t)
+//		...
+//	}
+//
+// Goroutines are checked with [testing.TB.Cleanup] registered at call time,
+// thus functions registered with Cleanup after NoLeaks, like closing servers,
+// run before goroutines are checked. Goroutines started by other tests running
+// in parallel are reported, use [VerifyTestMain] for such packages.
+//
+// Goroutines are given some time to exit before they are reported as
+// leaked. Use [LeakTimeout] to change it and [IgnoreTopFunction]
+// or [IgnoreCreatedBy] to ignore known goroutines.
+//
+// As leaks are checked when test completes, there is no equivalent
+// of this in package require.
+func NoLeaks(t testing.TB, opts ...LeakOption) {
 	t.Helper()
+	opts = append([]LeakOption{IgnoreCurrent()}, opts...)
+	t.Cleanup(func() {
+		t.Helper()
+		leaks := findLeaks(opts...)
+		if len(leaks) > 0 {
+			t.Errorf("Found %d unexpected goroutine(s):\n%s", len(leaks), formatLeaks(leaks))
+		}
+	})
+}
+
+// VerifyTestMain runs tests and checks for leaked goroutines
+// after all tests in the package have completed.
+// If tests pass, but goroutines are leaked, exit code is set to 1.
+//
+//	func TestMain(m *testing.M) {
+//		assert.VerifyTestMain(m)
+//	}
+func VerifyTestMain(m *testing.M, opts ...LeakOption) {
+	code := m.Run()
+	if code == 0 {
+		if leaks := findLeaks(opts...); len(leaks) > 0 {
+			fmt.Fprintf(os.Stderr,
+				"assert: found %d unexpected goroutine(s) after tests:\n%s",
+				len(leaks), formatLeaks(leaks))
+			code = 1
+		}
+	}
+	os.Exit(code)
+}
+
+// findLeaks returns goroutines which did not exit before timeout.
+func findLeaks(opts ...LeakOption) []goroutine {
+	o := &leakOptions{
+		timeout:   defaultLeakTimeout,
+		ignoreIDs: make(map[uint64]bool),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+
+	deadline := time.Now().Add(o.timeout)
+	delay := time.Microsecond
+	for {
+		leaks := filterLeaks(goroutines(), o)
+		if len(leaks) == 0 || time.Now().After(deadline) {
+			return leaks
+		}
+		time.Sleep(delay)
+		if delay < maxLeakBackoff {
+			delay *= 2
+		}
+	}
+}
+
+// filterLeaks removes current goroutine and ignored goroutines from list.
+func filterLeaks(items []goroutine, o *leakOptions) []goroutine {
+	current := currentGoroutineID()
+	var rv []goroutine
+	for _, g := range items {
+		if g.id == current || o.ignoreIDs[g.id] {
+			continue
+		}
+		if contains(defaultIgnoredEntries, g.entry) ||
+			contains(o.topFunctions, g.top) ||
+			contains(o.createdBy, g.createdBy) {
+			continue
+		}
+		rv = append(rv, g)
+	}
+	return rv
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// formatLeaks formats stacks of all leaked goroutines.
+func formatLeaks(leaks []goroutine) string {
+	var b strings.Builder
+	for i, g := range leaks {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(g.stack)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// stacks returns stack traces of all goroutines.
+func stacks(all bool) []byte {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, all)
+		if n < len(buf) {
+			return buf[:n]
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
+
+// goroutines returns snapshot of all running goroutines.
+func goroutines() []goroutine {
+	return parseGoroutines(stacks(true))
+}
+
+// currentGoroutineID returns ID of the calling goroutine.
+func currentGoroutineID() uint64 {
+	items := parseGoroutines(stacks(false))
+	if len(items) == 0 {
+		return 0
+	}
+	return items[0].id
+}
+
+// parseGoroutines parses output of [runtime.Stack].
+//
+//	goroutine 18 [chan receive]:
+//	example.com/pkg.worker(0xc000012345)
+//		/src/pkg/worker.go:10 +0x2c
+//	created by example.com/pkg.Start in goroutine 6
+//		/src/pkg/worker.go:4 +0x1d
+func parseGoroutines(buf []byte) []goroutine {
+	var rv []goroutine
+	for _, block := range bytes.Split(bytes.TrimSpace(buf), []byte("\n\n")) {
+		lines := strings.Split(string(block), "\n")
+		if len(lines) == 0 {
+			continue
+		}
+
+		// Parse header "goroutine <id> [<state>]:".
+		header, ok := strings.CutPrefix(lines[0], "goroutine ")
+		if !ok {
+			continue
+		}
+		idStr, _, _ := strings.Cut(header, " ")
+		id, err := strconv.ParseUint(idStr, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		g := goroutine{
+			id:    id,
+			stack: string(block),
+		}
+
+		// Function lines are followed by tab indented file:line.
+		for _, line := range lines[1:] {
+			if strings.HasPrefix(line, "\t") || line == "" {
+				continue
+			}
+			if after, ok := strings.CutPrefix(line, "created by "); ok {
+				after, _, _ = strings.Cut(after, " in goroutine ")
+				g.createdBy = after
+				continue
+			}
+			fn := funcName(line)
+			if g.top == "" {
+				g.top = fn
+			}
+			g.entry = fn
+		}
+		rv = append(rv, g)
+	}
+	return rv
+}
+
+// funcName strips arguments from function in a stack trace.
+func funcName(line string) string {
+	if strings.HasSuffix(line, ")") {
+		if idx := strings.LastIndexByte(line, '('); idx > 0 {
+			return line[:idx]
+		}
+	}
+	return line
 }
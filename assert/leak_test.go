@@ -0,0 +1,122 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tprasadtp/pkg/assert/asserttest"
+)
+
+const stackFixture = `goroutine 7 [running]:
+example.com/pkg.current()
+	/src/pkg/current.go:10 +0x2c
+
+goroutine 18 [chan receive, 2 minutes]:
+example.com/pkg.(*Worker).loop(0xc000012345, {0x1, 0x2})
+	/src/pkg/worker.go:10 +0x2c
+example.com/pkg.Start.func1()
+	/src/pkg/worker.go:4 +0x1d
+created by example.com/pkg.Start in goroutine 6
+	/src/pkg/worker.go:3 +0x1d
+
+goroutine 6 [chan receive]:
+testing.(*T).Run(0xc000007ba0, {0x5c1b2e, 0x8}, 0x5d0f48)
+	/usr/lib/go/src/testing/testing.go:1649 +0x3c8
+testing.tRunner(0xc000007ba0, 0x5d0f48)
+	/usr/lib/go/src/testing/testing.go:1595 +0xff
+created by testing.(*T).Run in goroutine 1
+	/usr/lib/go/src/testing/testing.go:1648 +0x3ad
+`
+
+func TestParseGoroutines(t *testing.T) {
+	items := parseGoroutines([]byte(stackFixture))
+	if len(items) != 3 {
+		t.Fatalf("expected 3 goroutines, got %d", len(items))
+	}
+
+	g := items[1]
+	if g.id != 18 {
+		t.Errorf("expected id=18, got=%d", g.id)
+	}
+	if g.top != "example.com/pkg.(*Worker).loop" {
+		t.Errorf("unexpected top function: %s", g.top)
+	}
+	if g.entry != "example.com/pkg.Start.func1" {
+		t.Errorf("unexpected entry function: %s", g.entry)
+	}
+	if g.createdBy != "example.com/pkg.Start" {
+		t.Errorf("unexpected created by: %s", g.createdBy)
+	}
+	if items[2].entry != "testing.tRunner" {
+		t.Errorf("unexpected entry function: %s", items[2].entry)
+	}
+}
+
+func TestFilterLeaks(t *testing.T) {
+	items := parseGoroutines([]byte(stackFixture))
+	t.Run("Default", func(t *testing.T) {
+		leaks := filterLeaks(items, &leakOptions{})
+		if len(leaks) != 2 {
+			t.Errorf("expected 2 leaks, got %d", len(leaks))
+		}
+	})
+	t.Run("IgnoreTopFunction", func(t *testing.T) {
+		o := &leakOptions{}
+		IgnoreTopFunction("example.com/pkg.(*Worker).loop")(o)
+		IgnoreTopFunction("example.com/pkg.current")(o)
+		if leaks := filterLeaks(items, o); len(leaks) != 0 {
+			t.Errorf("expected no leaks, got:\n%s", formatLeaks(leaks))
+		}
+	})
+	t.Run("IgnoreCreatedBy", func(t *testing.T) {
+		o := &leakOptions{}
+		IgnoreCreatedBy("example.com/pkg.Start")(o)
+		leaks := filterLeaks(items, o)
+		if len(leaks) != 1 || leaks[0].id != 7 {
+			t.Errorf("expected only goroutine 7, got:\n%s", formatLeaks(leaks))
+		}
+	})
+}
+
+func TestNoLeaks(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
+		NoLeaks(t)
+		done := make(chan struct{})
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			close(done)
+		}()
+	})
+	t.Run("Leaked", func(t *testing.T) {
+		stop := make(chan struct{})
+		defer close(stop)
+		r := asserttest.NewRecorder(t)
+		r.Run(func(tb testing.TB) {
+			NoLeaks(tb, LeakTimeout(10*time.Millisecond))
+			go func() {
+				<-stop
+			}()
+		})
+		msgs := r.Messages()
+		if len(msgs) != 1 || !strings.HasPrefix(msgs[0], "Found 1 unexpected goroutine(s):") {
+			t.Fatalf("expected 1 leak, got: %q", msgs)
+		}
+		if !strings.Contains(msgs[0], "created by github.com/tprasadtp/pkg/assert.TestNoLeaks") {
+			t.Errorf("leaked goroutine must be created by test: %s", msgs[0])
+		}
+	})
+	t.Run("IgnoresExisting", func(t *testing.T) {
+		stop := make(chan struct{})
+		defer close(stop)
+		// Started before NoLeaks, thus must not be reported.
+		go func() {
+			<-stop
+		}()
+		r := asserttest.NewRecorder(t)
+		r.Run(func(tb testing.TB) {
+			NoLeaks(tb, LeakTimeout(10*time.Millisecond))
+		})
+		Empty(t, r.Messages())
+	})
+}
@@ -0,0 +1,304 @@
+package assert
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// LogOption configures [LogRecorder].
+type LogOption func(*logOptions)
+
+type logOptions struct {
+	level   slog.Leveler
+	forward bool
+}
+
+// LogLevel sets minimum level of records to be recorded.
+// By default, records of all levels are recorded.
+func LogLevel(level slog.Leveler) LogOption {
+	return func(o *logOptions) {
+		o.level = level
+	}
+}
+
+// LogToTest forwards all recorded records to [testing.TB.Log],
+// so that they are shown when running tests with -v flag
+// or when the test fails.
+func LogToTest() LogOption {
+	return func(o *logOptions) {
+		o.forward = true
+	}
+}
+
+// LogRecord is a single log record captured by [LogRecorder].
+type LogRecord struct {
+	Time    time.Time
+	Level   slog.Level
+	Message string
+	// Attrs are flattened, resolved attributes of the record, including
+	// those added by [slog.Logger.With]. Keys of attributes within groups
+	// are qualified with group names separated by dots, like "request.method".
+	Attrs []slog.Attr
+}
+
+// Attr returns value of attribute with qualified key and reports if it is present.
+func (r LogRecord) Attr(key string) (slog.Value, bool) {
+	for _, a := range r.Attrs {
+		if a.Key == key {
+			return a.Value, true
+		}
+	}
+	return slog.Value{}, false
+}
+
+// String formats the record as level, message and attributes.
+//
+//	WARN "cache miss" request.method=GET
+func (r LogRecord) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %q", r.Level, r.Message)
+	for _, a := range r.Attrs {
+		fmt.Fprintf(&b, " %s=%s", a.Key, a.Value)
+	}
+	return b.String()
+}
+
+// logState is shared by a [LogRecorder] and all handlers derived from it.
+type logState struct {
+	t       testing.TB
+	opts    logOptions
+	mu      sync.Mutex
+	done    bool
+	records []LogRecord
+}
+
+var _ slog.Handler = (*LogRecorder)(nil)
+
+// LogRecorder is a [slog.Handler] which records all log records, so that
+// tests can assert on log output with [LogContains], [NoLogsAbove]
+// and [LogCount]. Handlers derived from it with WithAttrs and WithGroup
+// record to the same recorder.
+//
+//	rec := assert.NewLogRecorder(t)
+//	svc := NewService(slog.New(rec))
+//	svc.Reload()
+//	assert.LogContains(t, rec, slog.LevelWarn, "config changed",
+//		[]slog.Attr{slog.String("path", "config.yml")})
+//	assert.NoLogsAbove(t, rec, slog.LevelWarn)
+type LogRecorder struct {
+	state  *logState
+	attrs  []slog.Attr
+	prefix string // qualified group prefix with trailing dot, if any.
+}
+
+// NewLogRecorder returns a new [LogRecorder]. Records are forwarded to t,
+// if [LogToTest] option is specified.
+func NewLogRecorder(t testing.TB, opts ...LogOption) *LogRecorder {
+	t.Helper()
+	state := &logState{t: t}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&state.opts)
+		}
+	}
+	// Logging to t after test has completed panics.
+	t.Cleanup(func() {
+		state.mu.Lock()
+		defer state.mu.Unlock()
+		state.done = true
+	})
+	return &LogRecorder{state: state}
+}
+
+// Enabled reports whether records of the given level are recorded.
+func (r *LogRecorder) Enabled(_ context.Context, level slog.Level) bool {
+	if r.state.opts.level == nil {
+		return true
+	}
+	return level >= r.state.opts.level.Level()
+}
+
+// Handle records the log record.
+func (r *LogRecorder) Handle(_ context.Context, record slog.Record) error {
+	attrs := make([]slog.Attr, 0, len(r.attrs)+record.NumAttrs())
+	attrs = append(attrs, r.attrs...)
+	record.Attrs(func(a slog.Attr) bool {
+		attrs = appendAttr(attrs, r.prefix, a)
+		return true
+	})
+	item := LogRecord{
+		Time:    record.Time,
+		Level:   record.Level,
+		Message: record.Message,
+		Attrs:   attrs,
+	}
+
+	r.state.mu.Lock()
+	defer r.state.mu.Unlock()
+	r.state.records = append(r.state.records, item)
+	if r.state.opts.forward && !r.state.done {
+		r.state.t.Log(item.String())
+	}
+	return nil
+}
+
+// WithAttrs returns a handler which records to the same recorder
+// and adds attrs to all records.
+func (r *LogRecorder) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return r
+	}
+	rv := &LogRecorder{state: r.state, prefix: r.prefix}
+	rv.attrs = append(rv.attrs, r.attrs...)
+	for _, a := range attrs {
+		rv.attrs = appendAttr(rv.attrs, r.prefix, a)
+	}
+	return rv
+}
+
+// WithGroup returns a handler which records to the same recorder
+// and qualifies keys of all attributes with group name.
+func (r *LogRecorder) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return r
+	}
+	return &LogRecorder{state: r.state, attrs: r.attrs, prefix: r.prefix + name + "."}
+}
+
+// appendAttr appends resolved attribute a to attrs, flattening groups.
+func appendAttr(attrs []slog.Attr, prefix string, a slog.Attr) []slog.Attr {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return attrs
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		// Groups with empty key are inlined.
+		if a.Key != "" {
+			prefix = prefix + a.Key + "."
+		}
+		for _, item := range a.Value.Group() {
+			attrs = appendAttr(attrs, prefix, item)
+		}
+		return attrs
+	}
+	a.Key = prefix + a.Key
+	return append(attrs, a)
+}
+
+// Records returns all recorded records in order.
+func (r *LogRecorder) Records() []LogRecord {
+	r.state.mu.Lock()
+	defer r.state.mu.Unlock()
+	rv := make([]LogRecord, len(r.state.records))
+	copy(rv, r.state.records)
+	return rv
+}
+
+// Reset removes all recorded records.
+func (r *LogRecorder) Reset() {
+	r.state.mu.Lock()
+	defer r.state.mu.Unlock()
+	r.state.records = nil
+}
+
+// formatRecords formats records one per line for failure messages.
+func formatRecords(records []LogRecord) string {
+	if len(records) == 0 {
+		return "  <none>"
+	}
+	lines := make([]string, 0, len(records))
+	for _, item := range records {
+		lines = append(lines, "  "+item.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// logValueEqual reports if slog values x and y are equal. Unlike
+// [slog.Value.Equal], values of [slog.KindAny] holding uncomparable
+// types like slices and maps do not panic and are compared recursively.
+func logValueEqual(x, y slog.Value) bool {
+	if x.Kind() == slog.KindAny && y.Kind() == slog.KindAny {
+		return len(compare(x.Any(), y.Any(), nil)) == 0
+	}
+	return x.Equal(y)
+}
+
+// LogContains asserts that rec recorded a record with level, message msg
+// and all of the given attrs. Keys of attrs within groups must be qualified
+// with group names, or specified as [slog.Group].
+//
+//	assert.LogContains(t, rec, slog.LevelInfo, "request",
+//		[]slog.Attr{slog.Group("request", slog.String("method", "GET"))})
+func LogContains(t testing.TB, rec *LogRecorder, level slog.Level, msg string,
+	attrs []slog.Attr, args ...any,
+) bool {
+	t.Helper()
+	var want []slog.Attr
+	for _, a := range attrs {
+		want = appendAttr(want, "", a)
+	}
+
+	records := rec.Records()
+	for _, item := range records {
+		if item.Level != level || item.Message != msg {
+			continue
+		}
+		matched := true
+		for _, a := range want {
+			v, ok := item.Attr(a.Key)
+			if !ok || !logValueEqual(a.Value, v) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	expected := LogRecord{Level: level, Message: msg, Attrs: want}
+	fallback := fmt.Sprintf("No log record matching %s, recorded:\n%s", expected, formatRecords(records))
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// NoLogsAbove asserts that rec did not record any records with level above level.
+//
+//	assert.NoLogsAbove(t, rec, slog.LevelInfo) // no warnings or errors.
+func NoLogsAbove(t testing.TB, rec *LogRecorder, level slog.Level, args ...any) bool {
+	t.Helper()
+	var above []LogRecord
+	for _, item := range rec.Records() {
+		if item.Level > level {
+			above = append(above, item)
+		}
+	}
+	if len(above) == 0 {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected no log records above %s, got:\n%s", level, formatRecords(above))
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// LogCount asserts that rec recorded exactly n records with level.
+func LogCount(t testing.TB, rec *LogRecorder, level slog.Level, n int, args ...any) bool {
+	t.Helper()
+	var matched []LogRecord
+	for _, item := range rec.Records() {
+		if item.Level == level {
+			matched = append(matched, item)
+		}
+	}
+	if len(matched) == n {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected %d log records with level %s, got %d:\n%s",
+		n, level, len(matched), formatRecords(matched))
+	t.Error(msgf(fallback, args...))
+	return false
+}
@@ -0,0 +1,127 @@
+package assert
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/tprasadtp/pkg/assert/asserttest"
+)
+
+func TestLogRecorder(t *testing.T) {
+	rec := NewLogRecorder(t, LogToTest())
+	logger := slog.New(rec)
+	logger.Info("starting", "port", 8080)
+	logger.With("component", "cache").
+		WithGroup("request").
+		Warn("cache miss", "method", "GET", slog.Group("user", "id", 1))
+	logger.Debug("debug", slog.Group("empty"))
+
+	LogContains(t, rec, slog.LevelInfo, "starting", nil)
+	LogContains(t, rec, slog.LevelInfo, "starting", []slog.Attr{slog.Int("port", 8080)})
+	LogContains(t, rec, slog.LevelWarn, "cache miss", []slog.Attr{
+		slog.String("component", "cache"),
+		slog.String("request.method", "GET"),
+		slog.Group("request", slog.Group("user", slog.Int("id", 1))),
+	})
+	NoLogsAbove(t, rec, slog.LevelWarn)
+	LogCount(t, rec, slog.LevelInfo, 1)
+	LogCount(t, rec, slog.LevelError, 0)
+
+	records := rec.Records()
+	Len(t, records, 3)
+	Equal(t, "debug", records[2].Message)
+	Empty(t, records[2].Attrs)
+	Equal(t, `WARN "cache miss" component=cache request.method=GET request.user.id=1`,
+		records[1].String())
+
+	rec.Reset()
+	Empty(t, rec.Records())
+}
+
+func TestLogRecorder_Level(t *testing.T) {
+	rec := NewLogRecorder(t, LogLevel(slog.LevelWarn))
+	logger := slog.New(rec)
+	logger.Info("ignored")
+	logger.Error("failed")
+	LogCount(t, rec, slog.LevelInfo, 0)
+	LogCount(t, rec, slog.LevelError, 1)
+}
+
+func TestLogContains_Any(t *testing.T) {
+	rec := NewLogRecorder(t)
+	logger := slog.New(rec)
+	logger.Info("ports", "ports", []int{80, 443}, "labels", map[string]string{"a": "b"})
+
+	LogContains(t, rec, slog.LevelInfo, "ports", []slog.Attr{
+		slog.Any("ports", []int{80, 443}),
+		slog.Any("labels", map[string]string{"a": "b"}),
+	})
+	msgs := failureMessages(t, func(tb testing.TB) {
+		LogContains(tb, rec, slog.LevelInfo, "ports", []slog.Attr{slog.Any("ports", []int{80})})
+		LogContains(tb, rec, slog.LevelInfo, "ports", []slog.Attr{slog.Int("ports", 80)})
+	})
+	Len(t, msgs, 2)
+}
+
+func TestLogRecorder_FailureMessages(t *testing.T) {
+	type testCase struct {
+		name   string
+		f      func(tb testing.TB, rec *LogRecorder)
+		expect string
+	}
+	tt := []testCase{
+		{
+			name: "LogContains",
+			f: func(tb testing.TB, rec *LogRecorder) {
+				LogContains(tb, rec, slog.LevelWarn, "starting", []slog.Attr{slog.Int("port", 80)})
+			},
+			expect: "No log record matching WARN \"starting\" port=80, recorded:\n" +
+				"  INFO \"starting\" port=8080\n" +
+				"  ERROR \"failed\"",
+		},
+		{
+			name:   "NoLogsAbove",
+			f:      func(tb testing.TB, rec *LogRecorder) { NoLogsAbove(tb, rec, slog.LevelWarn) },
+			expect: "Expected no log records above WARN, got:\n  ERROR \"failed\"",
+		},
+		{
+			name:   "LogCount",
+			f:      func(tb testing.TB, rec *LogRecorder) { LogCount(tb, rec, slog.LevelWarn, 1) },
+			expect: "Expected 1 log records with level WARN, got 0:\n  <none>",
+		},
+		{
+			name: "CustomMessage",
+			f: func(tb testing.TB, rec *LogRecorder) {
+				LogCount(tb, rec, slog.LevelWarn, 1, "%s => warning not logged", "reload")
+			},
+			expect: "reload => warning not logged",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs := failureMessages(t, func(tb testing.TB) {
+				rec := NewLogRecorder(tb)
+				logger := slog.New(rec)
+				logger.Info("starting", "port", 8080)
+				logger.Error("failed")
+				tc.f(tb, rec)
+			})
+			if len(msgs) != 1 {
+				t.Fatalf("expected 1 failure, got %d: %q", len(msgs), msgs)
+			}
+			Equal(t, tc.expect, msgs[0])
+		})
+	}
+}
+
+func TestLogRecorder_AfterTest(t *testing.T) {
+	r := asserttest.NewRecorder(t)
+	var rec *LogRecorder
+	r.Run(func(tb testing.TB) {
+		rec = NewLogRecorder(tb, LogToTest())
+	})
+	// Must not log to completed test.
+	slog.New(rec).Info("after test")
+	Len(t, rec.Records(), 1)
+	Empty(t, r.Messages(asserttest.KindLog))
+}
@@ -0,0 +1,147 @@
+package assert
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// Number is a constraint for integer and floating point types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// InDelta asserts that expected and actual are within delta of each other.
+//
+//	assert.InDelta(t, 0.3, 0.1+0.2, 1e-9)
+func InDelta[T Number](t testing.TB, expected, actual T, delta float64, args ...any) bool {
+	t.Helper()
+	x, y := float64(expected), float64(actual)
+	if math.IsNaN(x) || math.IsNaN(y) || math.IsNaN(delta) {
+		t.Errorf("InDelta does not support NaN(expected=%v, actual=%v, delta=%v)", expected, actual, delta)
+		return false
+	}
+	diff := math.Abs(x - y)
+	if diff <= delta {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected %v and %v to be within %v, difference is %v",
+		expected, actual, delta, diff)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// InEpsilon asserts that expected and actual have a relative error
+// less than or equal to epsilon. Relative error is |expected-actual|/|expected|,
+// thus expected must not be zero.
+//
+//	assert.InEpsilon(t, 100, 101, 0.01)
+func InEpsilon[T Number](t testing.TB, expected, actual T, epsilon float64, args ...any) bool {
+	t.Helper()
+	x, y := float64(expected), float64(actual)
+	if math.IsNaN(x) || math.IsNaN(y) || math.IsNaN(epsilon) {
+		t.Errorf("InEpsilon does not support NaN(expected=%v, actual=%v, epsilon=%v)", expected, actual, epsilon)
+		return false
+	}
+	if x == 0 {
+		t.Errorf("InEpsilon requires expected value to be non-zero")
+		return false
+	}
+	relative := math.Abs(x-y) / math.Abs(x)
+	if relative <= epsilon {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected relative error between %v and %v to be at most %v, got %v",
+		expected, actual, epsilon, relative)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// Greater asserts that x is greater than y.
+func Greater[T cmp.Ordered](t testing.TB, x, y T, args ...any) bool {
+	t.Helper()
+	if cmp.Compare(x, y) > 0 {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected %s to be greater than %s",
+		formatValue(reflect.ValueOf(x)), formatValue(reflect.ValueOf(y)))
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// Less asserts that x is less than y.
+func Less[T cmp.Ordered](t testing.TB, x, y T, args ...any) bool {
+	t.Helper()
+	if cmp.Compare(x, y) < 0 {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected %s to be less than %s",
+		formatValue(reflect.ValueOf(x)), formatValue(reflect.ValueOf(y)))
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// unsortedIndex returns index of first element of s which is out of order
+// as per cmp, or -1 if s is sorted. If strict is true, equal
+// elements are considered out of order.
+func unsortedIndex[E any](s []E, compare func(a, b E) int, strict bool) int {
+	for i := 1; i < len(s); i++ {
+		c := compare(s[i-1], s[i])
+		if c > 0 || (strict && c == 0) {
+			return i
+		}
+	}
+	return -1
+}
+
+func reportUnsorted[E any](t testing.TB, s []E, idx int, order string, args ...any) {
+	t.Helper()
+	fallback := fmt.Sprintf("Expected elements to be sorted in %s order, but [%d]=%s and [%d]=%s are not",
+		order,
+		idx-1, formatValue(reflect.ValueOf(s[idx-1])),
+		idx, formatValue(reflect.ValueOf(s[idx])))
+	t.Error(msgf(fallback, args...))
+}
+
+// Sorted asserts that elements of s are sorted in non-decreasing order.
+func Sorted[S ~[]E, E cmp.Ordered](t testing.TB, s S, args ...any) bool {
+	t.Helper()
+	idx := unsortedIndex(s, cmp.Compare[E], false)
+	if idx < 0 {
+		return true
+	}
+	reportUnsorted(t, s, idx, "non-decreasing", args...)
+	return false
+}
+
+// StrictlySorted asserts that elements of s are sorted in strictly
+// increasing order, i.e. sorted without duplicates. This is useful
+// to verify monotonic values like time ordered identifiers.
+func StrictlySorted[S ~[]E, E cmp.Ordered](t testing.TB, s S, args ...any) bool {
+	t.Helper()
+	idx := unsortedIndex(s, cmp.Compare[E], true)
+	if idx < 0 {
+		return true
+	}
+	reportUnsorted(t, s, idx, "strictly increasing", args...)
+	return false
+}
+
+// SortedFunc asserts that elements of s are sorted in non-decreasing order
+// as per compare function, which must return a negative number when a < b,
+// a positive number when a > b and zero when a == b.
+//
+//	assert.SortedFunc(t, times, func(a, b time.Time) int { return a.Compare(b) })
+func SortedFunc[S ~[]E, E any](t testing.TB, s S, compare func(a, b E) int, args ...any) bool {
+	t.Helper()
+	idx := unsortedIndex(s, compare, false)
+	if idx < 0 {
+		return true
+	}
+	reportUnsorted(t, s, idx, "non-decreasing", args...)
+	return false
+}
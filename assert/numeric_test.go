@@ -0,0 +1,86 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNumeric(t *testing.T) {
+	InDelta(t, 0.3, 0.1+0.2, 1e-9)
+	InDelta(t, 10, 12, 2)
+	InDelta(t, uint8(12), uint8(10), 2)
+	InEpsilon(t, 100, 101, 0.01)
+	InEpsilon(t, -100.0, -99.5, 0.01)
+	Greater(t, 2, 1)
+	Greater(t, "b", "a")
+	Less(t, 1.5, 2.0)
+	Sorted(t, []int{1, 1, 2, 3})
+	Sorted(t, []string{})
+	StrictlySorted(t, []string{"a", "b", "c"})
+	SortedFunc(t, []time.Time{time.Unix(1, 0), time.Unix(2, 0)},
+		func(a, b time.Time) int { return a.Compare(b) })
+}
+
+func TestNumeric_FailureMessages(t *testing.T) {
+	type testCase struct {
+		name   string
+		f      func(tb testing.TB)
+		expect string
+	}
+	tt := []testCase{
+		{
+			name:   "InDelta",
+			f:      func(tb testing.TB) { InDelta(tb, 10, 13, 2) },
+			expect: "Expected 10 and 13 to be within 2, difference is 3",
+		},
+		{
+			name:   "InEpsilon",
+			f:      func(tb testing.TB) { InEpsilon(tb, 100, 110, 0.01) },
+			expect: "Expected relative error between 100 and 110 to be at most 0.01, got 0.1",
+		},
+		{
+			name:   "InEpsilonZero",
+			f:      func(tb testing.TB) { InEpsilon(tb, 0, 1, 0.01) },
+			expect: "InEpsilon requires expected value to be non-zero",
+		},
+		{
+			name:   "Greater",
+			f:      func(tb testing.TB) { Greater(tb, "a", "b") },
+			expect: `Expected "a" to be greater than "b"`,
+		},
+		{
+			name:   "Less",
+			f:      func(tb testing.TB) { Less(tb, 2, 2) },
+			expect: "Expected 2 to be less than 2",
+		},
+		{
+			name:   "Sorted",
+			f:      func(tb testing.TB) { Sorted(tb, []int{1, 3, 2}) },
+			expect: "Expected elements to be sorted in non-decreasing order, but [1]=3 and [2]=2 are not",
+		},
+		{
+			name:   "StrictlySorted",
+			f:      func(tb testing.TB) { StrictlySorted(tb, []int{1, 2, 2}) },
+			expect: "Expected elements to be sorted in strictly increasing order, but [1]=2 and [2]=2 are not",
+		},
+		{
+			name: "SortedFunc",
+			f: func(tb testing.TB) {
+				SortedFunc(tb, []string{"b", "A"}, func(a, b string) int {
+					return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+				})
+			},
+			expect: `Expected elements to be sorted in non-decreasing order, but [0]="b" and [1]="A" are not`,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs := failureMessages(t, tc.f)
+			if len(msgs) != 1 {
+				t.Fatalf("expected 1 failure, got %d: %q", len(msgs), msgs)
+			}
+			Equal(t, tc.expect, msgs[0])
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package assert
+
+import (
+	"math"
+	"reflect"
+	"sort"
+)
+
+// EqualOption configures how values are compared by [Equal] and [NotEqual].
+// Options can be passed along with custom message arguments, in any order.
+//
+//	assert.Equal(t, expected, got,
+//		assert.IgnoreFields("Info.GoVersion", "Info.BuildDate"),
+//		"%s => info mismatch", t.Name())
+type EqualOption func(*equalOptions)
+
+type equalOptions struct {
+	ignoreFields     map[string]bool
+	ignoreUnexported bool
+	equateEmpty      bool
+	approx           bool
+	fraction         float64
+	margin           float64
+	sorters          map[reflect.Type]func(reflect.Value) reflect.Value
+}
+
+// EqualOptions combines multiple options into a single option.
+// This is useful to define common options once and re-use them.
+func EqualOptions(opts ...EqualOption) EqualOption {
+	return func(o *equalOptions) {
+		for _, opt := range opts {
+			if opt != nil {
+				opt(o)
+			}
+		}
+	}
+}
+
+// IgnoreFields ignores struct fields specified as "Type.Field",
+// where Type is name of the struct type without package qualifier.
+//
+//	assert.IgnoreFields("Info.GoVersion", "Info.BuildDate")
+func IgnoreFields(fields ...string) EqualOption {
+	return func(o *equalOptions) {
+		if o.ignoreFields == nil {
+			o.ignoreFields = make(map[string]bool)
+		}
+		for _, f := range fields {
+			o.ignoreFields[f] = true
+		}
+	}
+}
+
+// IgnoreUnexported ignores all unexported struct fields.
+func IgnoreUnexported() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreUnexported = true
+	}
+}
+
+// EquateEmpty treats nil and empty slices and maps as equal.
+func EquateEmpty() EqualOption {
+	return func(o *equalOptions) {
+		o.equateEmpty = true
+	}
+}
+
+// EquateApprox treats floating point numbers x and y as equal if
+//
+//	|x-y| ≤ max(margin, fraction*min(|x|, |y|))
+//
+// Both fraction and margin must be non-negative. This also applies
+// to real and imaginary parts of complex numbers.
+func EquateApprox(fraction, margin float64) EqualOption {
+	if fraction < 0 || margin < 0 || math.IsNaN(fraction) || math.IsNaN(margin) {
+		panic("assert(EquateApprox): fraction and margin must be non-negative numbers")
+	}
+	return func(o *equalOptions) {
+		o.approx = true
+		o.fraction = fraction
+		o.margin = margin
+	}
+}
+
+// SortSlices sorts slices and arrays of type T using less before
+// comparing them. This is useful to compare slices ignoring order of elements.
+// Slices stored in unexported fields are not sorted.
+//
+//	assert.SortSlices(func(a, b string) bool { return a < b })
+func SortSlices[T any](less func(a, b T) bool) EqualOption {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	sorter := func(v reflect.Value) reflect.Value {
+		items := make([]T, v.Len())
+		for i := range items {
+			items[i], _ = v.Index(i).Interface().(T)
+		}
+		sort.SliceStable(items, func(i, j int) bool {
+			return less(items[i], items[j])
+		})
+		return reflect.ValueOf(items)
+	}
+	return func(o *equalOptions) {
+		if o.sorters == nil {
+			o.sorters = make(map[reflect.Type]func(reflect.Value) reflect.Value)
+		}
+		o.sorters[typ] = sorter
+	}
+}
+
+// splitOptions separates [EqualOption] values from message arguments.
+func splitOptions(args []any) (*equalOptions, []any) {
+	o := &equalOptions{}
+	var rest []any
+	for _, arg := range args {
+		if opt, ok := arg.(EqualOption); ok {
+			if opt != nil {
+				opt(o)
+			}
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	return o, rest
+}
+
+// ignoreField reports if field i of struct type typ should be ignored.
+func (o *equalOptions) ignoreField(typ reflect.Type, i int) bool {
+	f := typ.Field(i)
+	if o.ignoreUnexported && !f.IsExported() {
+		return true
+	}
+	return o.ignoreFields[typ.Name()+"."+f.Name]
+}
+
+// floatEqual reports if x and y are equal, taking approximation into account.
+func (o *equalOptions) floatEqual(x, y float64) bool {
+	if x == y {
+		return true
+	}
+	if !o.approx || math.IsNaN(x) || math.IsNaN(y) || math.IsInf(x, 0) || math.IsInf(y, 0) {
+		return false
+	}
+	return math.Abs(x-y) <= max(o.margin, o.fraction*min(math.Abs(x), math.Abs(y)))
+}
+
+// sorted returns sorted copy of slice or array v, if a sorter is
+// registered for its element type.
+func (o *equalOptions) sorted(v reflect.Value) reflect.Value {
+	if sorter, ok := o.sorters[v.Type().Elem()]; ok && v.CanInterface() {
+		return sorter(v)
+	}
+	return v
+}
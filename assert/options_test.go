@@ -0,0 +1,129 @@
+package assert
+
+import (
+	"math"
+	"testing"
+)
+
+type testOptionsInfo struct {
+	Version   string
+	GoVersion string
+	Tags      []string
+	Labels    map[string]string
+	Ratio     float64
+	internal  int
+}
+
+func TestEqualOptions(t *testing.T) {
+	type testCase struct {
+		name     string
+		expected testOptionsInfo
+		actual   testOptionsInfo
+		opts     []EqualOption
+		equal    bool
+	}
+	tt := []testCase{
+		{
+			name:     "ignore-fields",
+			expected: testOptionsInfo{Version: "v1", GoVersion: "go1.20"},
+			actual:   testOptionsInfo{Version: "v1", GoVersion: "go1.21"},
+			opts:     []EqualOption{IgnoreFields("testOptionsInfo.GoVersion")},
+			equal:    true,
+		},
+		{
+			name:     "ignore-fields-wrong-type",
+			expected: testOptionsInfo{GoVersion: "go1.20"},
+			actual:   testOptionsInfo{GoVersion: "go1.21"},
+			opts:     []EqualOption{IgnoreFields("Info.GoVersion")},
+		},
+		{
+			name:     "ignore-unexported",
+			expected: testOptionsInfo{internal: 1},
+			actual:   testOptionsInfo{internal: 2},
+			opts:     []EqualOption{IgnoreUnexported()},
+			equal:    true,
+		},
+		{
+			name:     "equate-empty",
+			expected: testOptionsInfo{Tags: []string{}, Labels: map[string]string{}},
+			actual:   testOptionsInfo{},
+			opts:     []EqualOption{EquateEmpty()},
+			equal:    true,
+		},
+		{
+			name:     "empty-not-equated",
+			expected: testOptionsInfo{Tags: []string{}},
+			actual:   testOptionsInfo{},
+		},
+		{
+			name:     "equate-approx",
+			expected: testOptionsInfo{Ratio: 0.3},
+			actual:   testOptionsInfo{Ratio: 0.1 + 0.2},
+			opts:     []EqualOption{EquateApprox(0, 1e-9)},
+			equal:    true,
+		},
+		{
+			name:     "equate-approx-exceeded",
+			expected: testOptionsInfo{Ratio: 1},
+			actual:   testOptionsInfo{Ratio: 1.2},
+			opts:     []EqualOption{EquateApprox(0.1, 0)},
+		},
+		{
+			name:     "equate-approx-nan",
+			expected: testOptionsInfo{Ratio: math.NaN()},
+			actual:   testOptionsInfo{Ratio: math.NaN()},
+			opts:     []EqualOption{EquateApprox(1, 1)},
+		},
+		{
+			name:     "sort-slices",
+			expected: testOptionsInfo{Tags: []string{"a", "b", "c"}},
+			actual:   testOptionsInfo{Tags: []string{"c", "a", "b"}},
+			opts: []EqualOption{
+				SortSlices(func(a, b string) bool { return a < b }),
+			},
+			equal: true,
+		},
+		{
+			name:     "combined",
+			expected: testOptionsInfo{Tags: []string{"a", "b"}, GoVersion: "go1.21"},
+			actual:   testOptionsInfo{Tags: []string{"b", "a"}, internal: 1},
+			opts: []EqualOption{
+				EqualOptions(
+					SortSlices(func(a, b string) bool { return a < b }),
+					IgnoreFields("testOptionsInfo.GoVersion"),
+				),
+				IgnoreUnexported(),
+			},
+			equal: true,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			args := make([]any, 0, len(tc.opts))
+			for _, opt := range tc.opts {
+				args = append(args, opt)
+			}
+			opts, rest := splitOptions(args)
+			if len(rest) != 0 {
+				t.Fatalf("options must not be treated as message args")
+			}
+			diffs := compare(tc.expected, tc.actual, opts)
+			if tc.equal && len(diffs) != 0 {
+				t.Errorf("expected values to be equal:\n%s", formatDiffs(diffs))
+			}
+			if !tc.equal && len(diffs) == 0 {
+				t.Errorf("expected values to differ")
+			}
+		})
+	}
+}
+
+func TestSplitOptions(t *testing.T) {
+	opts, rest := splitOptions([]any{"%s => %d", IgnoreUnexported(), "foo", 1})
+	if !opts.ignoreUnexported {
+		t.Errorf("option was not applied")
+	}
+	if msgf("fallback", rest...) != "foo => 1" {
+		t.Errorf("unexpected message: %s", msgf("fallback", rest...))
+	}
+}
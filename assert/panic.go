@@ -0,0 +1,125 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"runtime/debug"
+	"testing"
+)
+
+// PanicInfo describes a recovered panic.
+type PanicInfo struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the goroutine which panicked,
+	// captured while recovering.
+	Stack []byte
+}
+
+// String returns a formatted panic value and its stack trace.
+func (p *PanicInfo) String() string {
+	return fmt.Sprintf("panic: %v\n\n%s", p.Value, p.Stack)
+}
+
+// RecoverPanic calls f and returns recovered panic value and stack trace.
+// If f does not panic, it returns nil. This can be used to inspect
+// the panic value further, for example to check type of an error.
+//
+//	p := assert.RecoverPanic(func() { guid.MustParseGUID("") })
+//	if p == nil { ... }
+func RecoverPanic(f func()) *PanicInfo {
+	var info *PanicInfo
+	func() {
+		panicked := true
+		defer func() {
+			if panicked {
+				info = &PanicInfo{
+					Value: recover(),
+					Stack: debug.Stack(),
+				}
+			}
+		}()
+		f()
+		panicked = false
+	}()
+	return info
+}
+
+// PanicsWithValue asserts that function f panics with value equal to expected.
+// Values are compared like [Equal].
+//
+//	assert.PanicsWithValue(t, "invalid input", func() { parse("") })
+func PanicsWithValue(t testing.TB, expected any, f func(), args ...any) bool {
+	t.Helper()
+	p := RecoverPanic(f)
+	if p == nil {
+		fallback := fmt.Sprintf("Expected panic with value %s, but did not panic",
+			formatValue(reflect.ValueOf(expected)))
+		t.Error(msgf(fallback, args...))
+		return false
+	}
+	diffs := compare(expected, p.Value, nil)
+	if len(diffs) == 0 {
+		return true
+	}
+	fallback := fmt.Sprintf("Panic value is not equal:\n%s\n%s", formatDiffs(diffs), p.Stack)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// PanicsWithError asserts that function f panics with an error,
+// and any error in its tree matches target.
+//
+//	assert.PanicsWithError(t, ErrInvalid, func() { MustParse("") })
+func PanicsWithError(t testing.TB, target error, f func(), args ...any) bool {
+	t.Helper()
+	p := RecoverPanic(f)
+	if p == nil {
+		fallback := fmt.Sprintf("Expected panic with error %q, but did not panic", target)
+		t.Error(msgf(fallback, args...))
+		return false
+	}
+	err, ok := p.Value.(error)
+	if !ok {
+		fallback := fmt.Sprintf("Expected panic with an error, but got %T: %v\n%s",
+			p.Value, p.Value, p.Stack)
+		t.Error(msgf(fallback, args...))
+		return false
+	}
+	if errors.Is(err, target) {
+		return true
+	}
+	fallback := fmt.Sprintf("Panic error tree should contain error %q:\n%s\n%s",
+		target, formatErrorTree(err), p.Stack)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// PanicsMatching asserts that function f panics with a value, whose
+// string representation matches regular expression pattern. If panic
+// value is an error, its Error() method is used.
+//
+//	assert.PanicsMatching(t, `^invalid GUID`, func() { guid.MustParseGUID("") })
+func PanicsMatching(t testing.TB, pattern string, f func(), args ...any) bool {
+	t.Helper()
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		t.Errorf("Invalid pattern %q: %s", pattern, err)
+		return false
+	}
+	p := RecoverPanic(f)
+	if p == nil {
+		fallback := fmt.Sprintf("Expected panic matching %q, but did not panic", pattern)
+		t.Error(msgf(fallback, args...))
+		return false
+	}
+	s := fmt.Sprint(p.Value)
+	if re.MatchString(s) {
+		return true
+	}
+	fallback := fmt.Sprintf("Panic value %q does not match %q\n%s", s, pattern, p.Stack)
+	t.Error(msgf(fallback, args...))
+	return false
+}
@@ -0,0 +1,48 @@
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+)
+
+func panicWithStack() {
+	panic("from panicWithStack")
+}
+
+func TestRecoverPanic(t *testing.T) {
+	t.Run("NoPanic", func(t *testing.T) {
+		if p := RecoverPanic(func() {}); p != nil {
+			t.Errorf("expected nil, got %s", p)
+		}
+	})
+	t.Run("Panic", func(t *testing.T) {
+		p := RecoverPanic(panicWithStack)
+		if p == nil {
+			t.Fatalf("expected panic to be recovered")
+		}
+		Equal[any](t, "from panicWithStack", p.Value)
+		if !bytes.Contains(p.Stack, []byte("assert.panicWithStack")) {
+			t.Errorf("stack must contain panicking function:\n%s", p.Stack)
+		}
+	})
+	t.Run("PanicNil", func(t *testing.T) {
+		if p := RecoverPanic(func() { panic(nil) }); p == nil {
+			t.Errorf("expected panic(nil) to be recovered")
+		}
+	})
+}
+
+func TestPanics(t *testing.T) {
+	Panics(t, func() { panic("foo") })
+	NotPanics(t, func() {})
+	PanicsWithValue(t, "foo", func() { panic("foo") })
+	PanicsWithValue(t, []int{1, 2}, func() { panic([]int{1, 2}) })
+	PanicsWithError(t, fs.ErrNotExist, func() {
+		panic(fmt.Errorf("wrapped: %w", fs.ErrNotExist))
+	})
+	PanicsMatching(t, `^invalid GUID`, func() { panic(errors.New("invalid GUID: foo")) })
+	PanicsMatching(t, `\d+ items`, func() { panic("got 5 items") })
+}
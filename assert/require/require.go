@@ -0,0 +1,598 @@
+// Package require provides the same assertions as [github.com/tprasadtp/pkg/assert],
+// but unlike package assert, they stop the test on failure by calling
+// [testing.TB.FailNow]. Use this for assertions, which if failed, would make
+// rest of the test meaningless, for example a nil pointer dereference
+// after a failed [NoErrors].
+//
+//	v, err := Parse(input)
+//	require.NoErrors(t, err)
+//	assert.Equal(t, expected, v)
+//
+// Options like [assert.IgnoreFields] are defined in package assert
+// and can be passed to assertions in this package as well.
+package require
+
+import (
+	"cmp"
+	"context"
+	"io/fs"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/tprasadtp/pkg/assert"
+)
+
+// Panics asserts that function f panics.
+func Panics(t testing.TB, f func(), args ...any) {
+	t.Helper()
+	if !assert.Panics(t, f, args...) {
+		t.FailNow()
+	}
+}
+
+// NotPanics asserts that the given function does not panic.
+func NotPanics(t testing.TB, f func(), args ...any) {
+	t.Helper()
+	if !assert.NotPanics(t, f, args...) {
+		t.FailNow()
+	}
+}
+
+// IsError asserts than any error in "err"'s tree matches "target".
+func IsError(t testing.TB, err, target error, args ...any) {
+	t.Helper()
+	if !assert.IsError(t, err, target, args...) {
+		t.FailNow()
+	}
+}
+
+// NotIsError asserts than none of the errors in "err"'s tree match "target".
+func NotIsError(t testing.TB, err, target error, args ...any) {
+	t.Helper()
+	if !assert.NotIsError(t, err, target, args...) {
+		t.FailNow()
+	}
+}
+
+// Errors asserts that an error is not nil.
+func Errors(t testing.TB, err error, args ...any) {
+	t.Helper()
+	if !assert.Errors(t, err, args...) {
+		t.FailNow()
+	}
+}
+
+// NoErrors asserts that an error is nil.
+func NoErrors(t testing.TB, err error, args ...any) {
+	t.Helper()
+	if !assert.NoErrors(t, err, args...) {
+		t.FailNow()
+	}
+}
+
+// True asserts that an expression is true.
+func True(t testing.TB, ok bool, args ...any) {
+	t.Helper()
+	if !assert.True(t, ok, args...) {
+		t.FailNow()
+	}
+}
+
+// False asserts that an expression is false.
+func False(t testing.TB, ok bool, args ...any) {
+	t.Helper()
+	if !assert.False(t, ok, args...) {
+		t.FailNow()
+	}
+}
+
+// Equal asserts that expected and actual are equal.
+// See [assert.Equal] for details.
+func Equal[T any](t testing.TB, expected, actual T, args ...any) {
+	t.Helper()
+	if !assert.Equal(t, expected, actual, args...) {
+		t.FailNow()
+	}
+}
+
+// NotEqual asserts that expected and actual are not equal.
+func NotEqual[T any](t testing.TB, expected, actual T, args ...any) {
+	t.Helper()
+	if !assert.NotEqual(t, expected, actual, args...) {
+		t.FailNow()
+	}
+}
+
+// Golden asserts that got matches contents of golden file testdata/<name>.golden.
+// See [assert.Golden] for details.
+func Golden[T ~string | ~[]byte](t testing.TB, name string, got T, args ...any) {
+	t.Helper()
+	if !assert.Golden(t, name, got, args...) {
+		t.FailNow()
+	}
+}
+
+// GoldenFS asserts that all files in fsys match golden files in
+// directory testdata/<name>. See [assert.GoldenFS] for details.
+func GoldenFS(t testing.TB, name string, fsys fs.FS, args ...any) {
+	t.Helper()
+	if !assert.GoldenFS(t, name, fsys, args...) {
+		t.FailNow()
+	}
+}
+
+// Eventually asserts that cond returns true within timeout.
+func Eventually(t testing.TB, cond func() bool, timeout, tick time.Duration, args ...any) {
+	t.Helper()
+	if !assert.Eventually(t, cond, timeout, tick, args...) {
+		t.FailNow()
+	}
+}
+
+// Never asserts that cond does not return true within duration.
+func Never(t testing.TB, cond func() bool, duration, tick time.Duration, args ...any) {
+	t.Helper()
+	if !assert.Never(t, cond, duration, tick, args...) {
+		t.FailNow()
+	}
+}
+
+// EventuallyContext asserts that cond returns nil error before ctx is done.
+func EventuallyContext(t testing.TB, ctx context.Context, cond func(context.Context) error,
+	tick time.Duration, args ...any,
+) {
+	t.Helper()
+	if !assert.EventuallyContext(t, ctx, cond, tick, args...) {
+		t.FailNow()
+	}
+}
+
+// NeverContext asserts that cond never returns nil error before ctx is done.
+func NeverContext(t testing.TB, ctx context.Context, cond func(context.Context) error,
+	tick time.Duration, args ...any,
+) {
+	t.Helper()
+	if !assert.NeverContext(t, ctx, cond, tick, args...) {
+		t.FailNow()
+	}
+}
+
+// JSONEq asserts that expected and actual are semantically equal JSON documents.
+func JSONEq[T ~string | ~[]byte](t testing.TB, expected, actual T, args ...any) {
+	t.Helper()
+	if !assert.JSONEq(t, expected, actual, args...) {
+		t.FailNow()
+	}
+}
+
+// JSONPath asserts that value at path in JSON document data is equal to expected.
+func JSONPath[T ~string | ~[]byte](t testing.TB, data T, path string, expected any, args ...any) {
+	t.Helper()
+	if !assert.JSONPath(t, data, path, expected, args...) {
+		t.FailNow()
+	}
+}
+
+// PanicsWithValue asserts that function f panics with value equal to expected.
+func PanicsWithValue(t testing.TB, expected any, f func(), args ...any) {
+	t.Helper()
+	if !assert.PanicsWithValue(t, expected, f, args...) {
+		t.FailNow()
+	}
+}
+
+// PanicsWithError asserts that function f panics with an error,
+// and any error in its tree matches target.
+func PanicsWithError(t testing.TB, target error, f func(), args ...any) {
+	t.Helper()
+	if !assert.PanicsWithError(t, target, f, args...) {
+		t.FailNow()
+	}
+}
+
+// PanicsMatching asserts that function f panics with a value, whose
+// string representation matches regular expression pattern.
+func PanicsMatching(t testing.TB, pattern string, f func(), args ...any) {
+	t.Helper()
+	if !assert.PanicsMatching(t, pattern, f, args...) {
+		t.FailNow()
+	}
+}
+
+// ErrorAs asserts that any error in err's tree is of type T, and returns it.
+func ErrorAs[T error](t testing.TB, err error, args ...any) T {
+	t.Helper()
+	tracker := &failureTracker{TB: t}
+	target := assert.ErrorAs[T](tracker, err, args...)
+	if tracker.failed {
+		t.FailNow()
+	}
+	return target
+}
+
+// ErrorContains asserts that err is not nil and its message contains substr.
+func ErrorContains(t testing.TB, err error, substr string, args ...any) {
+	t.Helper()
+	if !assert.ErrorContains(t, err, substr, args...) {
+		t.FailNow()
+	}
+}
+
+// ErrorMatches asserts that err is not nil and its message matches
+// regular expression pattern.
+func ErrorMatches(t testing.TB, err error, pattern string, args ...any) {
+	t.Helper()
+	if !assert.ErrorMatches(t, err, pattern, args...) {
+		t.FailNow()
+	}
+}
+
+// MaxAllocs asserts that f allocates at most n times per run on average.
+func MaxAllocs(t testing.TB, n int, f func(), args ...any) {
+	t.Helper()
+	if !assert.MaxAllocs(t, n, f, args...) {
+		t.FailNow()
+	}
+}
+
+// MaxBytesPerOp asserts that f allocates at most n bytes per run on average.
+func MaxBytesPerOp(t testing.TB, n uint64, f func(), args ...any) {
+	t.Helper()
+	if !assert.MaxBytesPerOp(t, n, f, args...) {
+		t.FailNow()
+	}
+}
+
+// Contains asserts that container contains element.
+// See [assert.Contains] for supported containers.
+func Contains(t testing.TB, container, element any, args ...any) {
+	t.Helper()
+	if !assert.Contains(t, container, element, args...) {
+		t.FailNow()
+	}
+}
+
+// NotContains asserts that container does not contain element.
+func NotContains(t testing.TB, container, element any, args ...any) {
+	t.Helper()
+	if !assert.NotContains(t, container, element, args...) {
+		t.FailNow()
+	}
+}
+
+// HasKey asserts that map m contains key.
+func HasKey[M ~map[K]V, K comparable, V any](t testing.TB, m M, key K, args ...any) {
+	t.Helper()
+	if !assert.HasKey(t, m, key, args...) {
+		t.FailNow()
+	}
+}
+
+// ElementsMatch asserts that expected and actual contain the same
+// elements, ignoring their order.
+func ElementsMatch[S ~[]E, E any](t testing.TB, expected, actual S, args ...any) {
+	t.Helper()
+	if !assert.ElementsMatch(t, expected, actual, args...) {
+		t.FailNow()
+	}
+}
+
+// Subset asserts that all elements of subset are present in s.
+func Subset[S ~[]E, E any](t testing.TB, s, subset S, args ...any) {
+	t.Helper()
+	if !assert.Subset(t, s, subset, args...) {
+		t.FailNow()
+	}
+}
+
+// Len asserts that v has length n.
+func Len[T any](t testing.TB, v T, n int, args ...any) {
+	t.Helper()
+	if !assert.Len(t, v, n, args...) {
+		t.FailNow()
+	}
+}
+
+// Empty asserts that v is empty.
+func Empty[T any](t testing.TB, v T, args ...any) {
+	t.Helper()
+	if !assert.Empty(t, v, args...) {
+		t.FailNow()
+	}
+}
+
+// NotEmpty asserts that v is not empty.
+func NotEmpty[T any](t testing.TB, v T, args ...any) {
+	t.Helper()
+	if !assert.NotEmpty(t, v, args...) {
+		t.FailNow()
+	}
+}
+
+// InDelta asserts that expected and actual are within delta of each other.
+func InDelta[T assert.Number](t testing.TB, expected, actual T, delta float64, args ...any) {
+	t.Helper()
+	if !assert.InDelta(t, expected, actual, delta, args...) {
+		t.FailNow()
+	}
+}
+
+// InEpsilon asserts that expected and actual have a relative error
+// less than or equal to epsilon.
+func InEpsilon[T assert.Number](t testing.TB, expected, actual T, epsilon float64, args ...any) {
+	t.Helper()
+	if !assert.InEpsilon(t, expected, actual, epsilon, args...) {
+		t.FailNow()
+	}
+}
+
+// Greater asserts that x is greater than y.
+func Greater[T cmp.Ordered](t testing.TB, x, y T, args ...any) {
+	t.Helper()
+	if !assert.Greater(t, x, y, args...) {
+		t.FailNow()
+	}
+}
+
+// Less asserts that x is less than y.
+func Less[T cmp.Ordered](t testing.TB, x, y T, args ...any) {
+	t.Helper()
+	if !assert.Less(t, x, y, args...) {
+		t.FailNow()
+	}
+}
+
+// Sorted asserts that elements of s are sorted in non-decreasing order.
+func Sorted[S ~[]E, E cmp.Ordered](t testing.TB, s S, args ...any) {
+	t.Helper()
+	if !assert.Sorted(t, s, args...) {
+		t.FailNow()
+	}
+}
+
+// StrictlySorted asserts that elements of s are sorted in strictly
+// increasing order.
+func StrictlySorted[S ~[]E, E cmp.Ordered](t testing.TB, s S, args ...any) {
+	t.Helper()
+	if !assert.StrictlySorted(t, s, args...) {
+		t.FailNow()
+	}
+}
+
+// SortedFunc asserts that elements of s are sorted in non-decreasing order
+// as per compare function.
+func SortedFunc[S ~[]E, E any](t testing.TB, s S, compare func(a, b E) int, args ...any) {
+	t.Helper()
+	if !assert.SortedFunc(t, s, compare, args...) {
+		t.FailNow()
+	}
+}
+
+// WithinDuration asserts that expected and actual times are within delta
+// of each other.
+func WithinDuration(t testing.TB, expected, actual time.Time, delta time.Duration, args ...any) {
+	t.Helper()
+	if !assert.WithinDuration(t, expected, actual, delta, args...) {
+		t.FailNow()
+	}
+}
+
+// TimeBefore asserts that time x is before y.
+func TimeBefore(t testing.TB, x, y time.Time, args ...any) {
+	t.Helper()
+	if !assert.TimeBefore(t, x, y, args...) {
+		t.FailNow()
+	}
+}
+
+// TimeAfter asserts that time x is after y.
+func TimeAfter(t testing.TB, x, y time.Time, args ...any) {
+	t.Helper()
+	if !assert.TimeAfter(t, x, y, args...) {
+		t.FailNow()
+	}
+}
+
+// WithinRange asserts that actual is within time range [start, end].
+func WithinRange(t testing.TB, actual, start, end time.Time, args ...any) {
+	t.Helper()
+	if !assert.WithinRange(t, actual, start, end, args...) {
+		t.FailNow()
+	}
+}
+
+// failureTracker records if an assertion reported a failure, for assertions
+// which return a value instead of a bool.
+type failureTracker struct {
+	testing.TB
+	failed bool
+}
+
+func (f *failureTracker) Error(args ...any) {
+	f.TB.Helper()
+	f.failed = true
+	f.TB.Error(args...)
+}
+
+func (f *failureTracker) Errorf(format string, args ...any) {
+	f.TB.Helper()
+	f.failed = true
+	f.TB.Errorf(format, args...)
+}
+
+// Receives asserts that a value is received from ch within timeout
+// and returns it.
+func Receives[T any](t testing.TB, ch <-chan T, timeout time.Duration, args ...any) T {
+	t.Helper()
+	tracker := &failureTracker{TB: t}
+	v := assert.Receives(tracker, ch, timeout, args...)
+	if tracker.failed {
+		t.FailNow()
+	}
+	return v
+}
+
+// NotReceives asserts that no value is received from ch for duration.
+func NotReceives[T any](t testing.TB, ch <-chan T, duration time.Duration, args ...any) {
+	t.Helper()
+	if !assert.NotReceives(t, ch, duration, args...) {
+		t.FailNow()
+	}
+}
+
+// Closed asserts that ch is closed within timeout.
+func Closed[T any](t testing.TB, ch <-chan T, timeout time.Duration, args ...any) {
+	t.Helper()
+	if !assert.Closed(t, ch, timeout, args...) {
+		t.FailNow()
+	}
+}
+
+// ReceivesAll asserts that n values are received from ch within timeout
+// and returns them in order they were received.
+func ReceivesAll[T any](t testing.TB, ch <-chan T, n int, timeout time.Duration, args ...any) []T {
+	t.Helper()
+	tracker := &failureTracker{TB: t}
+	values := assert.ReceivesAll(tracker, ch, n, timeout, args...)
+	if tracker.failed {
+		t.FailNow()
+	}
+	return values
+}
+
+// LogContains asserts that rec recorded a record with level, message msg
+// and all of the given attrs.
+func LogContains(t testing.TB, rec *assert.LogRecorder, level slog.Level, msg string,
+	attrs []slog.Attr, args ...any,
+) {
+	t.Helper()
+	if !assert.LogContains(t, rec, level, msg, attrs, args...) {
+		t.FailNow()
+	}
+}
+
+// NoLogsAbove asserts that rec did not record any records with level above level.
+func NoLogsAbove(t testing.TB, rec *assert.LogRecorder, level slog.Level, args ...any) {
+	t.Helper()
+	if !assert.NoLogsAbove(t, rec, level, args...) {
+		t.FailNow()
+	}
+}
+
+// LogCount asserts that rec recorded exactly n records with level.
+func LogCount(t testing.TB, rec *assert.LogRecorder, level slog.Level, n int, args ...any) {
+	t.Helper()
+	if !assert.LogCount(t, rec, level, n, args...) {
+		t.FailNow()
+	}
+}
+
+// HTTPStatus asserts that response status code is code.
+func HTTPStatus[R assert.HTTPResponse](t testing.TB, resp R, code int, args ...any) {
+	t.Helper()
+	if !assert.HTTPStatus(t, resp, code, args...) {
+		t.FailNow()
+	}
+}
+
+// HTTPHeader asserts that response header key has value.
+func HTTPHeader[R assert.HTTPResponse](t testing.TB, resp R, key, value string, args ...any) {
+	t.Helper()
+	if !assert.HTTPHeader(t, resp, key, value, args...) {
+		t.FailNow()
+	}
+}
+
+// HTTPBodyJSON asserts that response body is a JSON document semantically
+// equal to expected.
+func HTTPBodyJSON[R assert.HTTPResponse](t testing.TB, resp R, expected any, args ...any) {
+	t.Helper()
+	if !assert.HTTPBodyJSON(t, resp, expected, args...) {
+		t.FailNow()
+	}
+}
+
+// HTTPBodyContains asserts that response body contains substr.
+func HTTPBodyContains[R assert.HTTPResponse](t testing.TB, resp R, substr string, args ...any) {
+	t.Helper()
+	if !assert.HTTPBodyContains(t, resp, substr, args...) {
+		t.FailNow()
+	}
+}
+
+// FileExists asserts that name exists in fsys and is a regular file.
+func FileExists(t testing.TB, fsys fs.FS, name string, args ...any) {
+	t.Helper()
+	if !assert.FileExists(t, fsys, name, args...) {
+		t.FailNow()
+	}
+}
+
+// NoFileExists asserts that name does not exist in fsys.
+func NoFileExists(t testing.TB, fsys fs.FS, name string, args ...any) {
+	t.Helper()
+	if !assert.NoFileExists(t, fsys, name, args...) {
+		t.FailNow()
+	}
+}
+
+// DirContainsExactly asserts that fsys contains exactly the regular files
+// specified by names, including those in subdirectories.
+func DirContainsExactly(t testing.TB, fsys fs.FS, names []string, args ...any) {
+	t.Helper()
+	if !assert.DirContainsExactly(t, fsys, names, args...) {
+		t.FailNow()
+	}
+}
+
+// FileMode asserts that mode of name in fsys is mode, including type bits.
+func FileMode(t testing.TB, fsys fs.FS, name string, mode fs.FileMode, args ...any) {
+	t.Helper()
+	if !assert.FileMode(t, fsys, name, mode, args...) {
+		t.FailNow()
+	}
+}
+
+// FileContains asserts that contents of file name in fsys contain substr.
+func FileContains(t testing.TB, fsys fs.FS, name, substr string, args ...any) {
+	t.Helper()
+	if !assert.FileContains(t, fsys, name, substr, args...) {
+		t.FailNow()
+	}
+}
+
+// FileNotContains asserts that contents of file name in fsys do not contain substr.
+func FileNotContains(t testing.TB, fsys fs.FS, name, substr string, args ...any) {
+	t.Helper()
+	if !assert.FileNotContains(t, fsys, name, substr, args...) {
+		t.FailNow()
+	}
+}
+
+// FileGzipContains asserts that decompressed contents of gzip compressed
+// file name in fsys contain substr.
+func FileGzipContains(t testing.TB, fsys fs.FS, name, substr string, args ...any) {
+	t.Helper()
+	if !assert.FileGzipContains(t, fsys, name, substr, args...) {
+		t.FailNow()
+	}
+}
+
+// FileGzipNotContains asserts that decompressed contents of gzip compressed
+// file name in fsys do not contain substr.
+func FileGzipNotContains(t testing.TB, fsys fs.FS, name, substr string, args ...any) {
+	t.Helper()
+	if !assert.FileGzipNotContains(t, fsys, name, substr, args...) {
+		t.FailNow()
+	}
+}
+
+// TreeEqual asserts that expected and actual contain exactly the same regular
+// files with the same contents.
+func TreeEqual(t testing.TB, expected, actual fs.FS, args ...any) {
+	t.Helper()
+	if !assert.TreeEqual(t, expected, actual, args...) {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,88 @@
+package require_test
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/tprasadtp/pkg/assert"
+	"github.com/tprasadtp/pkg/assert/asserttest"
+	"github.com/tprasadtp/pkg/assert/require"
+)
+
+func TestRequire(t *testing.T) {
+	errTest := errors.New("test error")
+	require.True(t, true)
+	require.False(t, false)
+	require.NoErrors(t, nil)
+	require.Errors(t, errTest)
+	require.IsError(t, errTest, errTest)
+	require.NotIsError(t, errTest, errors.New("other"))
+	require.Panics(t, func() { panic("test") })
+	require.NotPanics(t, func() {})
+	require.Equal(t, []int{1, 2}, []int{1, 2})
+	require.NotEqual(t, "foo", "bar")
+}
+
+func TestRequire_ErrorAs(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", syscall.Errno(0))
+	require.Equal(t, syscall.Errno(0), require.ErrorAs[syscall.Errno](t, err))
+}
+
+func TestRequire_StopsOnFailure(t *testing.T) {
+	r := asserttest.NewRecorder(t)
+	var reached bool
+	r.Run(func(tb testing.TB) {
+		require.NoErrors(tb, errors.New("test error"))
+		reached = true
+	})
+	if !r.Failed() {
+		t.Errorf("require.NoErrors must fail")
+	}
+	if reached {
+		t.Errorf("require.NoErrors must stop the test")
+	}
+}
+
+func TestRequire_Receives(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 1
+	require.Equal(t, 1, require.Receives(t, ch, time.Second))
+
+	r := asserttest.NewRecorder(t)
+	var reached bool
+	r.Run(func(tb testing.TB) {
+		require.Receives(tb, ch, time.Millisecond)
+		reached = true
+	})
+	if !r.Failed() {
+		t.Errorf("require.Receives must fail")
+	}
+	if reached {
+		t.Errorf("require.Receives must stop the test")
+	}
+}
+
+func TestRequire_LogContains(t *testing.T) {
+	rec := assert.NewLogRecorder(t)
+	slog.New(rec).Info("starting", "port", 8080)
+	require.LogContains(t, rec, slog.LevelInfo, "starting", []slog.Attr{slog.Int("port", 8080)})
+	require.NoLogsAbove(t, rec, slog.LevelInfo)
+	require.LogCount(t, rec, slog.LevelInfo, 1)
+
+	r := asserttest.NewRecorder(t)
+	var reached bool
+	r.Run(func(tb testing.TB) {
+		require.LogContains(tb, rec, slog.LevelWarn, "starting", nil)
+		reached = true
+	})
+	if !r.Failed() {
+		t.Errorf("require.LogContains must fail")
+	}
+	if reached {
+		t.Errorf("require.LogContains must stop the test")
+	}
+}
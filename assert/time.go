@@ -0,0 +1,68 @@
+package assert
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// WithinDuration asserts that expected and actual times are within delta
+// of each other.
+//
+//	assert.WithinDuration(t, time.Now(), got, time.Second)
+func WithinDuration(t testing.TB, expected, actual time.Time, delta time.Duration, args ...any) bool {
+	t.Helper()
+	diff := expected.Sub(actual)
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff <= delta {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected %s and %s to be within %s, difference is %s",
+		expected.Format(time.RFC3339Nano), actual.Format(time.RFC3339Nano), delta, diff)
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// TimeBefore asserts that time x is before y.
+func TimeBefore(t testing.TB, x, y time.Time, args ...any) bool {
+	t.Helper()
+	if x.Before(y) {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected %s to be before %s",
+		x.Format(time.RFC3339Nano), y.Format(time.RFC3339Nano))
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// TimeAfter asserts that time x is after y.
+func TimeAfter(t testing.TB, x, y time.Time, args ...any) bool {
+	t.Helper()
+	if x.After(y) {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected %s to be after %s",
+		x.Format(time.RFC3339Nano), y.Format(time.RFC3339Nano))
+	t.Error(msgf(fallback, args...))
+	return false
+}
+
+// WithinRange asserts that actual is within time range [start, end],
+// inclusive of both start and end.
+func WithinRange(t testing.TB, actual, start, end time.Time, args ...any) bool {
+	t.Helper()
+	if end.Before(start) {
+		t.Errorf("Invalid time range, end %s is before start %s",
+			end.Format(time.RFC3339Nano), start.Format(time.RFC3339Nano))
+		return false
+	}
+	if !actual.Before(start) && !actual.After(end) {
+		return true
+	}
+	fallback := fmt.Sprintf("Expected %s to be within range [%s, %s]",
+		actual.Format(time.RFC3339Nano), start.Format(time.RFC3339Nano), end.Format(time.RFC3339Nano))
+	t.Error(msgf(fallback, args...))
+	return false
+}
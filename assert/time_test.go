@@ -0,0 +1,65 @@
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTime(t *testing.T) {
+	now := time.Now()
+	WithinDuration(t, now, now.Add(-time.Second), time.Second)
+	TimeBefore(t, now, now.Add(time.Nanosecond))
+	TimeAfter(t, now, now.Add(-time.Nanosecond))
+	WithinRange(t, now, now, now.Add(time.Second))
+	WithinRange(t, now.Add(time.Second), now, now.Add(time.Second))
+}
+
+func TestTime_FailureMessages(t *testing.T) {
+	type testCase struct {
+		name   string
+		f      func(tb testing.TB)
+		expect string
+	}
+	tt := []testCase{
+		{
+			name: "WithinDuration",
+			f: func(tb testing.TB) {
+				WithinDuration(tb, time.Unix(10, 0).UTC(), time.Unix(13, 0).UTC(), time.Second)
+			},
+			expect: "Expected 1970-01-01T00:00:10Z and 1970-01-01T00:00:13Z to be within 1s, difference is 3s",
+		},
+		{
+			name:   "TimeBefore",
+			f:      func(tb testing.TB) { TimeBefore(tb, time.Unix(1, 0).UTC(), time.Unix(1, 0).UTC()) },
+			expect: "Expected 1970-01-01T00:00:01Z to be before 1970-01-01T00:00:01Z",
+		},
+		{
+			name:   "TimeAfter",
+			f:      func(tb testing.TB) { TimeAfter(tb, time.Unix(1, 0).UTC(), time.Unix(2, 0).UTC()) },
+			expect: "Expected 1970-01-01T00:00:01Z to be after 1970-01-01T00:00:02Z",
+		},
+		{
+			name: "WithinRange",
+			f: func(tb testing.TB) {
+				WithinRange(tb, time.Unix(3, 0).UTC(), time.Unix(1, 0).UTC(), time.Unix(2, 0).UTC())
+			},
+			expect: "Expected 1970-01-01T00:00:03Z to be within range [1970-01-01T00:00:01Z, 1970-01-01T00:00:02Z]",
+		},
+		{
+			name: "WithinRangeInvalid",
+			f: func(tb testing.TB) {
+				WithinRange(tb, time.Unix(1, 0).UTC(), time.Unix(2, 0).UTC(), time.Unix(1, 0).UTC())
+			},
+			expect: "Invalid time range, end 1970-01-01T00:00:01Z is before start 1970-01-01T00:00:02Z",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs := failureMessages(t, tc.f)
+			if len(msgs) != 1 {
+				t.Fatalf("expected 1 failure, got %d: %q", len(msgs), msgs)
+			}
+			Equal(t, tc.expect, msgs[0])
+		})
+	}
+}
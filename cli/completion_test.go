@@ -3,12 +3,13 @@ package cli_test
 import (
 	"bytes"
 	"fmt"
-	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/tprasadtp/pkg/assert"
 	"github.com/tprasadtp/pkg/cli"
 )
 
@@ -210,24 +211,15 @@ func Test_CompletionCmd_ToFile(t *testing.T) {
 				t.Fatalf("%s: must not return any error, but got %s", t.Name(), err)
 			}
 
-			// Open file and check if it is correct completion
-			outputFile, outputReadErr := os.Open(tc.Args[3])
-			if outputReadErr != nil {
-				t.Fatalf("failed to open file for verification: %s", outputReadErr)
-			}
-			// close and delete generated file.
+			// Delete generated file and check if it is correct completion.
 			t.Cleanup(func() {
-				outputFile.Close()
-				os.Remove(outputFile.Name())
+				os.Remove(tc.Args[3])
 			})
-			outputFileContents, outputReadErr := io.ReadAll(outputFile)
-			if outputReadErr != nil {
-				t.Fatalf("failed to read file for verification: %s", outputReadErr)
-			}
-
-			if !strings.Contains(string(outputFileContents), tc.FindString) {
-				t.Fatalf("generated file does not contain string: %s", tc.FindString)
-			}
+			fsys := os.DirFS(filepath.Dir(tc.Args[3]))
+			name := filepath.Base(tc.Args[3])
+			assert.FileExists(t, fsys, name)
+			assert.FileContains(t, fsys, name, tc.FindString,
+				"generated file does not contain string: %s", tc.FindString)
 		})
 	}
 }
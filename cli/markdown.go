@@ -21,12 +21,12 @@ func genMarkdown(cmd *cobra.Command, w io.Writer, layout string) error {
 	cmd.InitDefaultHelpCmd()
 	cmd.InitDefaultHelpFlag()
 
-	getLayout := func() string {
-		return layout
-	}
-	funcMap["getLayout"] = getLayout
-
-	tpl := template.New("markdown.tpl").Funcs(funcMap)
+	// Do not modify shared funcMap, as docs may be generated concurrently.
+	tpl := template.New("markdown.tpl").Funcs(funcMap).Funcs(template.FuncMap{
+		"getLayout": func() string {
+			return layout
+		},
+	})
 	tpl, err := tpl.Parse(defaultMarkdownTpl)
 	if err != nil {
 		return fmt.Errorf("failed to parse embedded markdown template: %w", err)
@@ -2,65 +2,42 @@ package cli
 
 import (
 	"bytes"
-	"io"
+	"fmt"
 	"os"
-	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
 
+	"github.com/spf13/cobra"
+	"github.com/tprasadtp/pkg/assert"
+	"github.com/tprasadtp/pkg/assert/require"
 	"github.com/tprasadtp/pkg/cli/internal/testcli"
+	"github.com/tprasadtp/pkg/race"
 )
 
 func Test_GenMarkdownTree_NoLayout(t *testing.T) {
 	output := t.TempDir()
 	root := testcli.GetTestCLI()
 	err := GenMarkdownTree(root, output)
-	if err != nil {
-		t.Fatalf("failed to generate markdown - %s", err)
-	}
-	token := []byte(testcli.HiddenToken)
-	filesWhichShouldBeGenerated := []string{
+	require.NoErrors(t, err, "failed to generate markdown - %s", err)
+
+	// Markdown for hidden and deprecated commands must not be generated.
+	fsys := os.DirFS(output)
+	files := []string{
 		"test-cli.md",
 		"test-cli-command1.md",
 		"test-cli-command1-subcommand1.md",
 		"test-cli-command1-subcommand2.md",
 		"test-cli-command2.md",
+		"test-cli-command3.md",
 	}
+	assert.DirContainsExactly(t, fsys, files)
 
-	// we know what output file is check if
-	// any deprecated flags are present.
-	// testcli.HiddenToken is in all usage messages.
-	for _, f := range filesWhichShouldBeGenerated {
-		filename := filepath.Join(output, f)
-		file, e := os.Open(filename)
-		if e != nil {
-			t.Errorf("failed to open md file - %s: %e", filename, e)
-			continue
-		}
-		content, e := io.ReadAll(file)
-		if e != nil {
-			t.Errorf("failed to read md file - %s : %s", filename, e)
-			file.Close()
-		}
-		file.Close()
-		if bytes.Contains(content, token) {
-			t.Errorf("hidden flag or command in help output - %s", filename)
-		}
-	}
-
-	dirList, err := os.ReadDir(output)
-	if err != nil {
-		t.Errorf("failed to list dir %s: %s", output, err)
-	}
-	filesWhichShouldNotBeGenerated := []string{
-		"test-cli-hidden.md",
-		"test-cli-deprecated.md",
-	}
-	for _, item := range dirList {
-		for _, lookupItem := range filesWhichShouldNotBeGenerated {
-			if item.Name() == lookupItem {
-				t.Errorf("%s should not be present as it belongs to hidden/deprecated cmd", lookupItem)
-			}
-		}
+	// testcli.HiddenToken is in all usage messages of
+	// hidden or deprecated flags and commands.
+	for _, f := range files {
+		assert.FileNotContains(t, fsys, f, testcli.HiddenToken,
+			"hidden flag or command in help output - %s", f)
 	}
 }
 
@@ -68,56 +45,27 @@ func Test_GenMarkdownTree_CustomLayout(t *testing.T) {
 	output := t.TempDir()
 	root := testcli.GetTestCLI()
 	err := GenMarkdownTree(root, output, "customLayout")
-	if err != nil {
-		t.Fatalf("failed to generate markdown - %s", err)
-	}
-	filesWhichShouldBeGenerated := []string{
+	require.NoErrors(t, err, "failed to generate markdown - %s", err)
+
+	// Markdown for hidden and deprecated commands must not be generated.
+	fsys := os.DirFS(output)
+	files := []string{
 		"test-cli.md",
 		"test-cli-command1.md",
 		"test-cli-command1-subcommand1.md",
 		"test-cli-command1-subcommand2.md",
 		"test-cli-command2.md",
+		"test-cli-command3.md",
 	}
+	assert.DirContainsExactly(t, fsys, files)
 
-	// we know what output file is check if
-	// any deprecated flags are present.
-	// testcli.HiddenToken is in all usage messages.
-	for _, f := range filesWhichShouldBeGenerated {
-		filename := filepath.Join(output, f)
-		file, e := os.Open(filename)
-		if e != nil {
-			t.Errorf("failed to open md file - %s: %e", filename, e)
-			continue
-		}
-		content, e := io.ReadAll(file)
-		if e != nil {
-			t.Errorf("failed to read md file - %s : %s", filename, e)
-			file.Close()
-		}
-		file.Close()
-		if bytes.Contains(content, []byte(testcli.HiddenToken)) {
-			t.Errorf("hidden flag or command in help output - %s", filename)
-		}
-
-		if !bytes.Contains(content, []byte("customLayout")) {
-			t.Errorf("custom layout not in rendered file")
-		}
-	}
-
-	dirList, err := os.ReadDir(output)
-	if err != nil {
-		t.Errorf("failed to list dir %s: %s", output, err)
-	}
-	filesWhichShouldNotBeGenerated := []string{
-		"test-cli-hidden.md",
-		"test-cli-deprecated.md",
-	}
-	for _, item := range dirList {
-		for _, lookupItem := range filesWhichShouldNotBeGenerated {
-			if item.Name() == lookupItem {
-				t.Errorf("%s should not be present as it belongs to hidden/deprecated cmd", lookupItem)
-			}
-		}
+	// testcli.HiddenToken is in all usage messages of
+	// hidden or deprecated flags and commands.
+	for _, f := range files {
+		assert.FileNotContains(t, fsys, f, testcli.HiddenToken,
+			"hidden flag or command in help output - %s", f)
+		assert.FileContains(t, fsys, f, "customLayout",
+			"custom layout not in rendered file - %s", f)
 	}
 }
 
@@ -132,3 +80,39 @@ func Test_GenMarkdownTree_OutputDirNotPresent(t *testing.T) {
 		t.Errorf("expected to error when output dir is not present")
 	}
 }
+
+func Test_GenMarkdownTree_Golden(t *testing.T) {
+	t.Setenv("SOURCE_DATE_EPOCH", "1136239445")
+	output := t.TempDir()
+	root := testcli.GetTestCLI()
+	err := GenMarkdownTree(root, output)
+	if err != nil {
+		t.Fatalf("failed to generate markdown - %s", err)
+	}
+	assert.GoldenFS(t, "markdown", os.DirFS(output))
+}
+
+func Test_genMarkdown_Stress(t *testing.T) {
+	const goroutines, iterations = 8, 20
+	// Cobra commands are modified while generating docs, and test CLI
+	// binds flags to package level variables. Thus, build command trees
+	// beforehand, so that only templates and their functions are shared.
+	roots := make([]*cobra.Command, goroutines*iterations)
+	for i := range roots {
+		roots[i] = testcli.GetTestCLI()
+	}
+
+	var counter atomic.Int64
+	race.Stress(t, goroutines, iterations, func() error {
+		i := counter.Add(1) - 1
+		layout := fmt.Sprintf("layout-%d", i)
+		var buf bytes.Buffer
+		if err := genMarkdown(roots[i], &buf, layout); err != nil {
+			return err
+		}
+		if !strings.Contains(buf.String(), "layout: "+layout+"\n") {
+			return fmt.Errorf("output does not contain layout %q", layout)
+		}
+		return nil
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/tprasadtp/pkg/assert"
 	"github.com/tprasadtp/pkg/cli/internal/testcli"
 )
 
@@ -67,20 +68,13 @@ func Test_getSeeAlso(t *testing.T) {
 		},
 	}
 	for _, tc := range tt {
-		t.Run(tc.Name, func(t *testing.T) {
+		assert.GroupRun(t, tc.Name, func(g *assert.G) {
 			got := getSeeAlso(tc.Cmd)
-			if len(got) != len(tc.ExpectNames) {
-				t.Fatalf(
-					"expected %d results, got %d",
-					len(tc.ExpectNames),
-					len(got))
-			}
-
-			for i, item := range got {
-				if tc.ExpectNames[i] != item.Name() {
-					t.Errorf("at index %d expected %s, got %s", i, tc.ExpectNames[i], item.Name())
-				}
+			names := make([]string, 0, len(got))
+			for _, item := range got {
+				names = append(names, item.Name())
 			}
+			assert.Equal(g, tc.ExpectNames, names, assert.EquateEmpty())
 		})
 	}
 }
@@ -153,22 +147,13 @@ func Test_getFlags(t *testing.T) {
 		},
 	}
 	for _, tc := range tt {
-		t.Run(tc.Name, func(t *testing.T) {
+		assert.GroupRun(t, tc.Name, func(g *assert.G) {
 			flags := getFlags(tc.Cmd)
-			if len(flags) != len(tc.ExpectFlags) {
-				t.Fatalf(
-					"expected %d flags from %s flagset, got %d",
-					len(tc.ExpectFlags),
-					tc.Cmd.CommandPath(),
-					len(flags),
-				)
-			}
-
-			for i, item := range flags {
-				if tc.ExpectFlags[i] != item.Name {
-					t.Errorf("at index %d expected %s flag, got %s", i, tc.ExpectFlags[i], item.Name)
-				}
+			names := make([]string, 0, len(flags))
+			for _, item := range flags {
+				names = append(names, item.Name)
 			}
+			assert.Equal(g, tc.ExpectFlags, names, assert.EquateEmpty())
 		})
 	}
 }
@@ -198,6 +183,26 @@ func Test_formatGeneratedAt_SOURCE_DATE_EPOCH_Invalid(t *testing.T) {
 	}
 }
 
+func Test_formatGeneratedAt_SOURCE_DATE_EPOCH_Invalid_RFC3339(t *testing.T) {
+	t.Setenv("SOURCE_DATE_EPOCH", "foo-bar")
+	// RFC3339 has second precision, thus truncate start time.
+	start := time.Now().Truncate(time.Second)
+	output := formatGeneratedAt(time.RFC3339)
+	end := time.Now()
+	// re-parse output back to time.
+	outputTime, err := time.Parse(time.RFC3339, output)
+	assert.NoErrors(t, err)
+	assert.WithinRange(t, outputTime, start, end,
+		"output should be current time, when SOURCE_DATE_EPOCH is invalid")
+}
+
+func Test_formatGeneratedAt_SOURCE_DATE_EPOCH_ValidUnixTS_RFC3339(t *testing.T) {
+	t.Setenv("SOURCE_DATE_EPOCH", "1136239445")
+	outputTime, err := time.Parse(time.RFC3339, formatGeneratedAt(time.RFC3339))
+	assert.NoErrors(t, err)
+	assert.WithinDuration(t, time.Unix(1136239445, 0), outputTime, 0)
+}
+
 func Test_isAutoGenDisabled(t *testing.T) {
 	type testCase struct {
 		Name     string
@@ -242,11 +247,8 @@ func Test_isAutoGenDisabled(t *testing.T) {
 		},
 	}
 	for _, tc := range tt {
-		t.Run(tc.Name, func(t *testing.T) {
-			output := isAutoGenDisabled(tc.Cmd)
-			if output != tc.Expected {
-				t.Errorf("expected %v, got %v", tc.Expected, output)
-			}
+		assert.GroupRun(t, tc.Name, func(g *assert.G) {
+			assert.Equal(g, tc.Expected, isAutoGenDisabled(tc.Cmd))
 		})
 	}
 }
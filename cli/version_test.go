@@ -3,10 +3,12 @@ package cli_test
 import (
 	"bytes"
 	"encoding/json"
+	"runtime"
 	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/tprasadtp/pkg/assert"
 	"github.com/tprasadtp/pkg/cli"
 	"github.com/tprasadtp/pkg/version"
 )
@@ -78,6 +80,8 @@ func Test_VersionCmd_Template(t *testing.T) {
 				if jerr := json.Unmarshal(stdout.Bytes(), &version.Info{}); jerr != nil {
 					t.Errorf("stdout: must return json output, got=%s", jerr)
 				}
+				assert.JSONPath(t, stdout.Bytes(), "$.goVersion", runtime.Version())
+				assert.JSONPath(t, stdout.Bytes(), "$.version", version.GetInfo().Version)
 				if stderr.String() != "" {
 					t.Errorf("stdout: expected empty, got=%s", stderr.String())
 				}
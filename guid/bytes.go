@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: Copyright 2023 Prasad Tengse
+// SPDX-License-Identifier: MIT
+
+package guid
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// FromBytes creates a GUID from 16 bytes in [RFC 9562] network byte order,
+// where Data1, Data2 and Data3 are big endian. This is the layout used by
+// most non-Windows systems and databases storing GUIDs as binary(16).
+//
+// [RFC 9562]: https://www.rfc-editor.org/rfc/rfc9562#name-uuid-format
+func FromBytes(b []byte) (GUID, error) {
+	if len(b) != 16 {
+		return GUID{}, fmt.Errorf("invalid GUID length(%d), must be 16 bytes", len(b))
+	}
+	return FromArray([16]byte(b)), nil
+}
+
+// FromBytesLE creates a GUID from 16 bytes in Windows in-memory layout,
+// where Data1, Data2 and Data3 are little endian.
+func FromBytesLE(b []byte) (GUID, error) {
+	if len(b) != 16 {
+		return GUID{}, fmt.Errorf("invalid GUID length(%d), must be 16 bytes", len(b))
+	}
+	return FromArrayLE([16]byte(b)), nil
+}
+
+// FromArray is like [FromBytes], but takes a [16]byte array
+// and thus cannot fail.
+func FromArray(b [16]byte) GUID {
+	g := GUID{
+		Data1: binary.BigEndian.Uint32(b[0:4]),
+		Data2: binary.BigEndian.Uint16(b[4:6]),
+		Data3: binary.BigEndian.Uint16(b[6:8]),
+	}
+	copy(g.Data4[:], b[8:])
+	return g
+}
+
+// FromArrayLE is like [FromBytesLE], but takes a [16]byte array
+// and thus cannot fail.
+func FromArrayLE(b [16]byte) GUID {
+	g := GUID{
+		Data1: binary.LittleEndian.Uint32(b[0:4]),
+		Data2: binary.LittleEndian.Uint16(b[4:6]),
+		Data3: binary.LittleEndian.Uint16(b[6:8]),
+	}
+	copy(g.Data4[:], b[8:])
+	return g
+}
+
+// Bytes returns GUID as 16 bytes in [RFC 9562] network byte order.
+// This is the inverse of [FromBytes] and [FromArray].
+// Use b[:] to get a byte slice.
+//
+// [RFC 9562]: https://www.rfc-editor.org/rfc/rfc9562#name-uuid-format
+func (g GUID) Bytes() [16]byte {
+	var b [16]byte
+	binary.BigEndian.PutUint32(b[0:4], g.Data1)
+	binary.BigEndian.PutUint16(b[4:6], g.Data2)
+	binary.BigEndian.PutUint16(b[6:8], g.Data3)
+	copy(b[8:], g.Data4[:])
+	return b
+}
+
+// BytesLE returns GUID as 16 bytes in Windows in-memory layout.
+// This is the inverse of [FromBytesLE] and [FromArrayLE].
+// Hex encoding of these bytes is the same as [GUID.String].
+func (g GUID) BytesLE() [16]byte {
+	var b [16]byte
+	binary.LittleEndian.PutUint32(b[0:4], g.Data1)
+	binary.LittleEndian.PutUint16(b[4:6], g.Data2)
+	binary.LittleEndian.PutUint16(b[6:8], g.Data3)
+	copy(b[8:], g.Data4[:])
+	return b
+}
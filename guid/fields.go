@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: Copyright 2023 Prasad Tengse
+// SPDX-License-Identifier: MIT
+
+package guid
+
+import (
+	"strconv"
+	"time"
+)
+
+// Variant is the variant of the GUID, which determines layout of the
+// remaining bits. See [RFC 9562] for details.
+//
+// [RFC 9562]: https://www.rfc-editor.org/rfc/rfc9562#name-variant-field
+type Variant uint8
+
+const (
+	// VariantNCS is reserved for backward compatibility with NCS.
+	// [Nil] GUID is of this variant.
+	VariantNCS Variant = iota
+
+	// VariantRFC4122 is the variant specified in RFC 4122 and RFC 9562.
+	// All GUIDs generated by this package are of this variant.
+	VariantRFC4122
+
+	// VariantMicrosoft is reserved for backward compatibility with
+	// older Microsoft GUIDs.
+	VariantMicrosoft
+
+	// VariantFuture is reserved for future definition.
+	// [Max] GUID is of this variant.
+	VariantFuture
+)
+
+// String returns name of the variant.
+func (v Variant) String() string {
+	switch v {
+	case VariantNCS:
+		return "NCS"
+	case VariantRFC4122:
+		return "RFC4122"
+	case VariantMicrosoft:
+		return "Microsoft"
+	case VariantFuture:
+		return "Future"
+	default:
+		return "Variant(" + strconv.Itoa(int(v)) + ")"
+	}
+}
+
+//nolint:gochecknoglobals // GUID is a struct and cannot be a constant.
+var (
+	// Nil is the GUID with all bits set to zero.
+	Nil = GUID{}
+
+	// Max is the GUID with all bits set to one.
+	Max = GUID{
+		Data1: 0xffffffff,
+		Data2: 0xffff,
+		Data3: 0xffff,
+		Data4: [8]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+)
+
+// Number of 100ns intervals between Gregorian epoch (1582-10-15)
+// and unix epoch, used by v1 and v6 timestamps.
+const gregorianToUnix = 0x01b21dd213814000
+
+// IsNil returns true if GUID is the [Nil] GUID. This is the same as [GUID.IsZero].
+func (g GUID) IsNil() bool {
+	return g == Nil
+}
+
+// IsMax returns true if GUID is the [Max] GUID.
+func (g GUID) IsMax() bool {
+	return g == Max
+}
+
+// Variant returns the variant of the GUID.
+func (g GUID) Variant() Variant {
+	switch {
+	case g.Data4[0]&0x80 == 0x00:
+		return VariantNCS
+	case g.Data4[0]&0xc0 == 0x80:
+		return VariantRFC4122
+	case g.Data4[0]&0xe0 == 0xc0:
+		return VariantMicrosoft
+	default:
+		return VariantFuture
+	}
+}
+
+// Version returns the version of the GUID (1-8). Version is only defined
+// for [VariantRFC4122] GUIDs. For other variants, 0 is returned.
+//
+// Accessors interpret fields as defined in [RFC 9562]. As [ParseGUID]
+// expects the Windows text layout, GUIDs in RFC 9562 text form, like
+// test vectors, must be created with [FromBytes] instead.
+//
+//	if id.Variant() != guid.VariantRFC4122 || id.Version() != 4 {
+//		return fmt.Errorf("invalid request id %s", id)
+//	}
+//
+// [RFC 9562]: https://www.rfc-editor.org/rfc/rfc9562#name-version-field
+func (g GUID) Version() int {
+	if g.Variant() != VariantRFC4122 {
+		return 0
+	}
+	return int(g.Data3 >> 12)
+}
+
+// Time returns the timestamp embedded in time based GUIDs.
+// For v1 and v6 GUIDs, timestamp has 100ns precision and for
+// v7 GUIDs, timestamp has millisecond precision.
+// For other versions, zero time is returned. Like [GUID.Version],
+// fields are interpreted as defined in RFC 9562.
+func (g GUID) Time() time.Time {
+	var ts int64
+	switch g.Version() {
+	case 1:
+		ts = int64(g.Data3&0x0fff)<<48 | int64(g.Data2)<<32 | int64(g.Data1)
+	case 6:
+		ts = int64(g.Data1)<<28 | int64(g.Data2)<<12 | int64(g.Data3&0x0fff)
+	case 7:
+		ms := int64(g.Data1)<<16 | int64(g.Data2)
+		return time.UnixMilli(ms)
+	default:
+		return time.Time{}
+	}
+	ts -= gregorianToUnix
+	return time.Unix(ts/1e7, (ts%1e7)*100)
+}
+
+// ClockSequence returns the 14 bit clock sequence of v1 and v6 GUIDs.
+// For other versions, -1 is returned.
+func (g GUID) ClockSequence() int {
+	switch g.Version() {
+	case 1, 6:
+		return int(g.Data4[0]&0x3f)<<8 | int(g.Data4[1])
+	default:
+		return -1
+	}
+}
+
+// NodeID returns the 6 byte node ID of v1 and v6 GUIDs.
+// For other versions, nil is returned.
+func (g GUID) NodeID() []byte {
+	switch g.Version() {
+	case 1, 6:
+		node := make([]byte, 6)
+		copy(node, g.Data4[2:])
+		return node
+	default:
+		return nil
+	}
+}
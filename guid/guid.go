@@ -6,6 +6,8 @@
 // This representation of GUID is compatible with [golang.org/x/sys/windows]
 // and can be used wherever syscall interface/func expects [golang.org/x/sys/windows.GUID].
 // Unlike [github.com/google/uuid], encoding is always little endian.
+// Use [GUID.Bytes] and [FromBytes] to exchange GUIDs with systems
+// which store them in RFC 9562 network byte order.
 package guid
 
 import (
@@ -57,29 +59,16 @@ func (g GUID) String() string {
 
 // AppendString appends hexadecimal encoded string representation of GUID to buf.
 func (g GUID) AppendString(buf []byte) []byte {
-	guidBytes := make([]byte, 0, 16)
-	guidBytes = binary.LittleEndian.AppendUint32(guidBytes, g.Data1)
-	guidBytes = binary.LittleEndian.AppendUint16(guidBytes, g.Data2)
-	guidBytes = binary.LittleEndian.AppendUint16(guidBytes, g.Data3)
-	guidBytes = append(guidBytes, g.Data4[:]...)
-
-	hexBuf := make([]byte, 36)
-
-	hex.Encode(hexBuf[:8], guidBytes[:4])
-	hexBuf[8] = '-'
-
-	hex.Encode(hexBuf[9:13], guidBytes[4:6])
-	hexBuf[13] = '-'
-
-	hex.Encode(hexBuf[14:18], guidBytes[6:8])
-	hexBuf[18] = '-'
-
-	hex.Encode(hexBuf[19:23], guidBytes[8:10])
-	hexBuf[23] = '-'
-
-	hex.Encode(hexBuf[24:], guidBytes[10:])
-
-	return append(buf, hexBuf...)
+	const hexTable = "0123456789abcdef"
+
+	for i, v := range g.BytesLE() {
+		// Separators are before Data2, Data3, Data4 and 3rd byte of Data4.
+		if i == 4 || i == 6 || i == 8 || i == 10 {
+			buf = append(buf, '-')
+		}
+		buf = append(buf, hexTable[v>>4], hexTable[v&0x0f])
+	}
+	return buf
 }
 
 // MarshalText returns the text representation of the GUID.
@@ -125,12 +114,12 @@ func ParseGUID[T ~string | ~[]byte](input T) (GUID, error) {
 
 	// Hex encoded 16 bytes is 32 bits + 4 for - separators.
 	if len(b) != 36 {
-		return GUID{}, fmt.Errorf("invalid GUID %q", b)
+		return GUID{}, fmt.Errorf("invalid GUID %q", input)
 	}
 
 	// Ensure separators are at correct positions.
 	if b[8] != '-' || b[13] != '-' || b[18] != '-' || b[23] != '-' {
-		return GUID{}, fmt.Errorf("invalid GUID %q", b)
+		return GUID{}, fmt.Errorf("invalid GUID %q", input)
 	}
 
 	guidBytes := make([]byte, 16)
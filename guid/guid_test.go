@@ -4,11 +4,15 @@ package guid_test
 
 import (
 	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/tprasadtp/pkg/assert"
 	"github.com/tprasadtp/pkg/guid"
-	"github.com/tprasadtp/pkg/race"
 )
 
 const (
@@ -38,22 +42,336 @@ func TestNewGUID(t *testing.T) {
 	})
 
 	t.Run("String-Allocs", func(t *testing.T) {
-		if race.Enabled {
-			t.Skipf("%s => skipping allocation tests in race mode", t.Name())
-		}
-
 		v := guid.NewGUID()
 		var str string
-		allocs := testing.AllocsPerRun(10, func() {
+		assert.MaxAllocs(t, 1, func() {
 			str = v.String()
 		})
-		if allocs > 1 {
-			t.Errorf("GUID.String() allocates > 1: %f", allocs)
-		}
 		_ = str
 	})
 }
 
+// rfcKey returns hex encoded GUID in RFC byte order, which sorts by time for v7.
+func rfcKey(g guid.GUID) string {
+	b := g.Bytes()
+	return hex.EncodeToString(b[:])
+}
+
+func TestNewV7(t *testing.T) {
+	t.Run("Version", func(t *testing.T) {
+		v := guid.NewV7()
+		version := v.Data3 & 0xF000 >> 12
+		if version != 7 {
+			t.Errorf("version should be (7)")
+		}
+	})
+	t.Run("Variant", func(t *testing.T) {
+		v := guid.NewV7()
+		variant := v.Data4[0] & 0xc0
+		if variant != 0x80 {
+			t.Errorf("variant should be VariantRFC4122")
+		}
+	})
+	t.Run("Time", func(t *testing.T) {
+		start := time.Now().Truncate(time.Millisecond)
+		v := guid.NewV7()
+		// Embedded timestamp may be slightly ahead of the clock,
+		// if many GUIDs were generated within the same millisecond.
+		assert.WithinRange(t, v.Time(), start, time.Now().Add(time.Second))
+	})
+	t.Run("Time-NotTimeBased", func(t *testing.T) {
+		v := guid.NewGUID()
+		assert.True(t, v.Time().IsZero(), "v4 GUID should return zero time")
+		assert.Equal(t, -1, v.ClockSequence())
+		assert.Equal(t, []byte(nil), v.NodeID())
+	})
+	t.Run("Sorted", func(t *testing.T) {
+		keys := make([]string, 10000)
+		for i := range keys {
+			keys[i] = rfcKey(guid.NewV7())
+		}
+		assert.StrictlySorted(t, keys)
+	})
+	t.Run("Sorted-Concurrent", func(t *testing.T) {
+		const goroutines = 16
+		const count = 1000
+		var wg sync.WaitGroup
+		results := make([][]string, goroutines)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				keys := make([]string, count)
+				for j := range keys {
+					keys[j] = rfcKey(guid.NewV7())
+				}
+				results[i] = keys
+			}(i)
+		}
+		wg.Wait()
+
+		seen := make(map[string]bool, goroutines*count)
+		for i, keys := range results {
+			assert.StrictlySorted(t, keys, "goroutine %d => GUIDs are not sorted", i)
+			for _, k := range keys {
+				if seen[k] {
+					t.Errorf("duplicate GUID: %s", k)
+				}
+				seen[k] = true
+			}
+		}
+	})
+}
+
+func TestNewV5(t *testing.T) {
+	tt := []struct {
+		name      string
+		namespace guid.GUID
+		input     string
+		expect    string
+	}{
+		// RFC 9562, Appendix A.4.
+		{name: "DNS", namespace: guid.NamespaceDNS, input: "www.example.com", expect: "2ed6657de927568b95e12665a8aea6a2"},
+		// Generated with python uuid module.
+		{name: "URL", namespace: guid.NamespaceURL, input: "https://www.example.com/", expect: "3d3ed9d2aa3d5fa690e8ed662e90f559"},
+		{name: "OID", namespace: guid.NamespaceOID, input: "1.3.6.1", expect: "1447fa6152775fefa9b3fbc6e44f4af3"},
+		{name: "X500", namespace: guid.NamespaceX500, input: "cn=John Doe", expect: "6b28d549d26e5bfcae5e9a39af63dc3f"},
+		{name: "Empty", namespace: guid.NamespaceDNS, input: "", expect: "4ebd020883285d698c44ec50939c0967"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			v := guid.NewV5(tc.namespace, []byte(tc.input))
+			assert.Equal(t, tc.expect, rfcKey(v))
+			assert.Equal(t, uint16(5), v.Data3>>12, "version should be (5)")
+			assert.Equal(t, byte(0x80), v.Data4[0]&0xc0, "variant should be VariantRFC4122")
+			assert.Equal(t, v, guid.NewV5(tc.namespace, []byte(tc.input)), "GUID should be deterministic")
+		})
+	}
+}
+
+func TestNamespaces(t *testing.T) {
+	assert.Equal(t, rfcGUID(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"), guid.NamespaceDNS)
+	assert.Equal(t, rfcGUID(t, "6ba7b811-9dad-11d1-80b4-00c04fd430c8"), guid.NamespaceURL)
+	assert.Equal(t, rfcGUID(t, "6ba7b812-9dad-11d1-80b4-00c04fd430c8"), guid.NamespaceOID)
+	assert.Equal(t, rfcGUID(t, "6ba7b814-9dad-11d1-80b4-00c04fd430c8"), guid.NamespaceX500)
+	assert.Equal(t, "10b8a76b-ad9d-d111-80b4-00c04fd430c8", guid.NamespaceDNS.String())
+}
+
+func TestNewV3(t *testing.T) {
+	tt := []struct {
+		name      string
+		namespace guid.GUID
+		input     string
+		expect    string
+	}{
+		// RFC 9562, Appendix A.2.
+		{name: "DNS", namespace: guid.NamespaceDNS, input: "www.example.com", expect: "5df418813aed351588a72f4a814cf09e"},
+		// Generated with python uuid module.
+		{name: "URL", namespace: guid.NamespaceURL, input: "https://www.example.com/", expect: "7fed185f0864319f875ba3d5458e30ac"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			v := guid.NewV3(tc.namespace, []byte(tc.input))
+			assert.Equal(t, tc.expect, rfcKey(v))
+			assert.Equal(t, uint16(3), v.Data3>>12, "version should be (3)")
+			assert.Equal(t, byte(0x80), v.Data4[0]&0xc0, "variant should be VariantRFC4122")
+		})
+	}
+}
+
+func TestGUID_Bytes(t *testing.T) {
+	v := guid.MustParseGUID("6bb6f6f2-8a38-42c4-868f-be3a285b33a7")
+	rfc := []byte{
+		0xf2, 0xf6, 0xb6, 0x6b, 0x38, 0x8a, 0xc4, 0x42,
+		0x86, 0x8f, 0xbe, 0x3a, 0x28, 0x5b, 0x33, 0xa7,
+	}
+	le := []byte{
+		0x6b, 0xb6, 0xf6, 0xf2, 0x8a, 0x38, 0x42, 0xc4,
+		0x86, 0x8f, 0xbe, 0x3a, 0x28, 0x5b, 0x33, 0xa7,
+	}
+	t.Run("Bytes", func(t *testing.T) {
+		b := v.Bytes()
+		assert.Equal(t, rfc, b[:])
+	})
+	t.Run("BytesLE", func(t *testing.T) {
+		b := v.BytesLE()
+		assert.Equal(t, le, b[:])
+	})
+	t.Run("FromBytes", func(t *testing.T) {
+		g, err := guid.FromBytes(rfc)
+		assert.NoErrors(t, err)
+		assert.Equal(t, v, g)
+	})
+	t.Run("FromBytesLE", func(t *testing.T) {
+		g, err := guid.FromBytesLE(le)
+		assert.NoErrors(t, err)
+		assert.Equal(t, v, g)
+	})
+	t.Run("FromArray", func(t *testing.T) {
+		assert.Equal(t, v, guid.FromArray([16]byte(rfc)))
+		assert.Equal(t, v, guid.FromArrayLE([16]byte(le)))
+	})
+	t.Run("InvalidLength", func(t *testing.T) {
+		for _, b := range [][]byte{nil, rfc[:15], append(rfc, 0x00)} {
+			_, err := guid.FromBytes(b)
+			assert.Errors(t, err, "FromBytes(len=%d) should return an error", len(b))
+			_, err = guid.FromBytesLE(b)
+			assert.Errors(t, err, "FromBytesLE(len=%d) should return an error", len(b))
+		}
+	})
+	t.Run("RoundTrip", func(t *testing.T) {
+		for _, g := range []guid.GUID{guid.NewGUID(), guid.NewV7(), guid.NewV5(guid.NamespaceURL, []byte("x"))} {
+			assert.Equal(t, g, guid.FromArray(g.Bytes()))
+			assert.Equal(t, g, guid.FromArrayLE(g.BytesLE()))
+		}
+	})
+	t.Run("Allocs", func(t *testing.T) {
+		assert.MaxAllocs(t, 0, func() {
+			_ = v.Bytes()
+			_ = v.BytesLE()
+			_, _ = guid.FromBytes(rfc)
+		})
+	})
+}
+
+// rfcGUID creates a GUID from string in RFC byte order, as used by test vectors in RFC 9562.
+func rfcGUID(t *testing.T, s string) guid.GUID {
+	t.Helper()
+	b, err := hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+	assert.NoErrors(t, err)
+	v, err := guid.FromBytes(b)
+	assert.NoErrors(t, err)
+	return v
+}
+
+func TestGUID_Fields(t *testing.T) {
+	// Test vectors from RFC 9562, Appendix A.
+	// All time based vectors embed 2022-02-22 14:22:22 -05:00.
+	ts := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+	node := []byte{0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46}
+	tt := []struct {
+		name    string
+		input   string
+		version int
+		time    time.Time
+		clock   int
+		node    []byte
+	}{
+		{name: "v1", input: "C232AB00-9414-11EC-B3C8-9F6BDECED846", version: 1, time: ts, clock: 0x33c8, node: node},
+		{name: "v3", input: "5df41881-3aed-3515-88a7-2f4a814cf09e", version: 3, clock: -1},
+		{name: "v4", input: "919108f7-52d1-4320-9bac-f847db4148a8", version: 4, clock: -1},
+		{name: "v5", input: "2ed6657d-e927-568b-95e1-2665a8aea6a2", version: 5, clock: -1},
+		{name: "v6", input: "1EC9414C-232A-6B00-B3C8-9F6BDECED846", version: 6, time: ts, clock: 0x33c8, node: node},
+		{name: "v7", input: "017F22E2-79B0-7CC3-98C4-DC0C0C07398F", version: 7, time: ts, clock: -1},
+		{name: "v8", input: "2489E9AD-2EE2-8E00-8EC9-32D5F69181C0", version: 8, clock: -1},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			v := rfcGUID(t, tc.input)
+			assert.Equal(t, guid.VariantRFC4122, v.Variant())
+			assert.Equal(t, tc.version, v.Version())
+			assert.Equal(t, tc.time, v.Time())
+			assert.Equal(t, tc.clock, v.ClockSequence())
+			assert.Equal(t, tc.node, v.NodeID())
+
+			// Text round trip must preserve fields.
+			rt := guid.MustParseGUID(v.String())
+			assert.Equal(t, v.Version(), rt.Version())
+			assert.Equal(t, v.Time(), rt.Time())
+		})
+	}
+	t.Run("v1-SubSecond", func(t *testing.T) {
+		// Timestamp 0x1ec9414c232ab01 is 100ns after the RFC vector.
+		v := rfcGUID(t, "C232AB01-9414-11EC-B3C8-9F6BDECED846")
+		assert.Equal(t, ts.Add(100*time.Nanosecond), v.Time())
+	})
+	t.Run("Generated", func(t *testing.T) {
+		assert.Equal(t, 4, guid.NewGUID().Version())
+		assert.Equal(t, 7, guid.NewV7().Version())
+		assert.Equal(t, 3, guid.NewV3(guid.NamespaceDNS, nil).Version())
+		assert.Equal(t, 5, guid.NewV5(guid.NamespaceDNS, nil).Version())
+		assert.Equal(t, guid.VariantRFC4122, guid.NewGUID().Variant())
+		assert.Equal(t, guid.VariantRFC4122, guid.NewV7().Variant())
+	})
+}
+
+func TestGUID_Variant(t *testing.T) {
+	tt := []struct {
+		data4  byte
+		expect guid.Variant
+	}{
+		{data4: 0x00, expect: guid.VariantNCS},
+		{data4: 0x7f, expect: guid.VariantNCS},
+		{data4: 0x80, expect: guid.VariantRFC4122},
+		{data4: 0xbf, expect: guid.VariantRFC4122},
+		{data4: 0xc0, expect: guid.VariantMicrosoft},
+		{data4: 0xdf, expect: guid.VariantMicrosoft},
+		{data4: 0xe0, expect: guid.VariantFuture},
+		{data4: 0xff, expect: guid.VariantFuture},
+	}
+	for _, tc := range tt {
+		t.Run(fmt.Sprintf("%#02x", tc.data4), func(t *testing.T) {
+			v := guid.NewGUID()
+			v.Data4[0] = tc.data4
+			assert.Equal(t, tc.expect, v.Variant())
+			if tc.expect != guid.VariantRFC4122 {
+				assert.Equal(t, 0, v.Version(), "version is only defined for VariantRFC4122")
+				assert.True(t, v.Time().IsZero(), "time is only defined for VariantRFC4122")
+			}
+		})
+	}
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "NCS", guid.VariantNCS.String())
+		assert.Equal(t, "RFC4122", guid.VariantRFC4122.String())
+		assert.Equal(t, "Microsoft", guid.VariantMicrosoft.String())
+		assert.Equal(t, "Future", guid.VariantFuture.String())
+		assert.Equal(t, "Variant(10)", guid.Variant(10).String())
+	})
+}
+
+func TestGUID_NilMax(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		assert.Equal(t, zeroGUID, guid.Nil.String())
+		assert.True(t, guid.Nil.IsNil())
+		assert.True(t, guid.Nil.IsZero())
+		assert.False(t, guid.Nil.IsMax())
+		assert.Equal(t, guid.VariantNCS, guid.Nil.Variant())
+		assert.Equal(t, 0, guid.Nil.Version())
+	})
+	t.Run("Max", func(t *testing.T) {
+		assert.Equal(t, "ffffffff-ffff-ffff-ffff-ffffffffffff", guid.Max.String())
+		assert.True(t, guid.Max.IsMax())
+		assert.False(t, guid.Max.IsNil())
+		assert.Equal(t, guid.VariantFuture, guid.Max.Variant())
+		assert.Equal(t, 0, guid.Max.Version())
+	})
+	t.Run("Generated", func(t *testing.T) {
+		v := guid.NewGUID()
+		assert.False(t, v.IsNil())
+		assert.False(t, v.IsMax())
+	})
+}
+
+func TestGUID_Allocs(t *testing.T) {
+	t.Run("AppendString", func(t *testing.T) {
+		v := guid.NewGUID()
+		buf := make([]byte, 0, 36)
+		assert.MaxAllocs(t, 0, func() {
+			buf = v.AppendString(buf[:0])
+		})
+	})
+	t.Run("ParseGUID", func(t *testing.T) {
+		input := "93b2c683-d8af-4cd0-8ca2-bf8177871a3b"
+		assert.MaxAllocs(t, 0, func() {
+			_, _ = guid.ParseGUID(input)
+		})
+		inputBytes := []byte(input)
+		assert.MaxAllocs(t, 0, func() {
+			_, _ = guid.ParseGUID(inputBytes)
+		})
+	})
+}
+
 func TestGUID_Encoding(t *testing.T) {
 	t.Run("windows", func(t *testing.T) {
 		expect := "6bb6f6f2-8a38-42c4-868f-be3a285b33a7"
@@ -319,10 +637,15 @@ func TestParse_Invalid(t *testing.T) {
 
 func TestMustParse(t *testing.T) {
 	t.Run("Invalid", func(t *testing.T) {
-		assert.Panics(t, func() {
+		assert.PanicsMatching(t, `^invalid GUID ""$`, func() {
 			guid.MustParseGUID("")
 		})
 	})
+	t.Run("InvalidHex", func(t *testing.T) {
+		assert.PanicsMatching(t, `^invalid GUID\(Data1\): `, func() {
+			guid.MustParseGUID("93?2c683-d8af-4cd0-8ca2-bf8177871a3b")
+		})
+	})
 	t.Run("Valid", func(t *testing.T) {
 		v := guid.MustParseGUID("93b2c683-d8af-4cd0-8ca2-bf8177871a3b")
 		expected := "93b2c683-d8af-4cd0-8ca2-bf8177871a3b"
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: Copyright 2023 Prasad Tengse
+// SPDX-License-Identifier: MIT
+
+package guid
+
+import (
+	"crypto/md5"  //nolint:gosec // required by RFC 9562 for v3 GUIDs.
+	"crypto/sha1" //nolint:gosec // required by RFC 9562 for v5 GUIDs.
+	"hash"
+)
+
+// Well known namespaces as defined in [RFC 9562].
+//
+// Values in parentheses are in RFC 9562 text form. [GUID.String] encodes
+// the Windows memory layout instead, thus NamespaceDNS.String() returns
+// 10b8a76b-ad9d-d111-80b4-00c04fd430c8. Use [FromBytes] and [GUID.Bytes]
+// to convert to and from RFC 9562 byte order.
+//
+// [RFC 9562]: https://www.rfc-editor.org/rfc/rfc9562#name-namespace-id-usage-and-allo
+//
+//nolint:gochecknoglobals // GUID is a struct and cannot be a constant.
+var (
+	// NamespaceDNS is namespace for fully qualified domain names (6ba7b810-9dad-11d1-80b4-00c04fd430c8).
+	NamespaceDNS = GUID{
+		Data1: 0x6ba7b810,
+		Data2: 0x9dad,
+		Data3: 0x11d1,
+		Data4: [8]byte{0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8},
+	}
+
+	// NamespaceURL is namespace for URLs (6ba7b811-9dad-11d1-80b4-00c04fd430c8).
+	NamespaceURL = GUID{
+		Data1: 0x6ba7b811,
+		Data2: 0x9dad,
+		Data3: 0x11d1,
+		Data4: [8]byte{0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8},
+	}
+
+	// NamespaceOID is namespace for ISO OIDs (6ba7b812-9dad-11d1-80b4-00c04fd430c8).
+	NamespaceOID = GUID{
+		Data1: 0x6ba7b812,
+		Data2: 0x9dad,
+		Data3: 0x11d1,
+		Data4: [8]byte{0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8},
+	}
+
+	// NamespaceX500 is namespace for X.500 DNs (6ba7b814-9dad-11d1-80b4-00c04fd430c8).
+	NamespaceX500 = GUID{
+		Data1: 0x6ba7b814,
+		Data2: 0x9dad,
+		Data3: 0x11d1,
+		Data4: [8]byte{0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8},
+	}
+)
+
+// NewV3 generates a name based [RFC 9562] v3 GUID, using MD5 hash of
+// namespace and name. Same namespace and name always generate the same GUID.
+// Hash is computed over RFC 9562 byte order, thus [GUID.Bytes] matches
+// other implementations, but [GUID.String] does not. See [NamespaceDNS].
+// Prefer [NewV5] unless compatibility with existing v3 GUIDs is required.
+//
+// [RFC 9562]: https://www.rfc-editor.org/rfc/rfc9562#name-uuid-version-3
+func NewV3(namespace GUID, name []byte) GUID {
+	return newHashed(md5.New(), 3, namespace, name) //nolint:gosec // required by RFC 9562.
+}
+
+// NewV5 generates a name based [RFC 9562] v5 GUID, using SHA-1 hash of
+// namespace and name. Same namespace and name always generate the same GUID.
+// Like [NewV3], [GUID.Bytes] matches other implementations.
+//
+//	id := guid.NewV5(guid.NamespaceDNS, []byte("www.example.com"))
+//
+// [RFC 9562]: https://www.rfc-editor.org/rfc/rfc9562#name-uuid-version-5
+func NewV5(namespace GUID, name []byte) GUID {
+	return newHashed(sha1.New(), 5, namespace, name) //nolint:gosec // required by RFC 9562.
+}
+
+// newHashed generates name based GUID of given version using hash h.
+// RFC 9562 hashes namespace in network byte order, thus
+// fields are always encoded as big endian.
+func newHashed(h hash.Hash, version uint16, namespace GUID, name []byte) GUID {
+	b := namespace.Bytes()
+	h.Write(b[:])
+	h.Write(name)
+	sum := h.Sum(nil)
+
+	g := FromArray([16]byte(sum[:16]))
+	g.Data3 = (g.Data3 & 0x0fff) | (version << 12) // set UUID version
+	g.Data4[0] = (g.Data4[0] & 0x3f) | 0x80        // set type to RFC 4122
+	return g
+}
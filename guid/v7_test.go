@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: Copyright 2023 Prasad Tengse
+// SPDX-License-Identifier: MIT
+package guid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tprasadtp/pkg/assert"
+)
+
+func TestNextV7Time(t *testing.T) {
+	// Timestamps used here are in the future, restore the state,
+	// so that other tests are not affected.
+	v7Clock.mu.Lock()
+	last := v7Clock.last
+	v7Clock.mu.Unlock()
+	t.Cleanup(func() {
+		v7Clock.mu.Lock()
+		v7Clock.last = last
+		v7Clock.mu.Unlock()
+	})
+
+	now := time.Now()
+	t.Run("SubMillisecond", func(t *testing.T) {
+		base := now.Add(time.Hour).Truncate(time.Millisecond)
+		a := nextV7Time(base.Add(100 * time.Microsecond))
+		b := nextV7Time(base.Add(900 * time.Microsecond))
+		assert.Equal(t, base.UnixMilli(), a>>12)
+		assert.Equal(t, base.UnixMilli(), b>>12)
+		assert.Equal(t, int64(409), a&0x0fff)
+		assert.Equal(t, int64(3686), b&0x0fff)
+	})
+	t.Run("SameInstant", func(t *testing.T) {
+		ts := now.Add(2 * time.Hour)
+		a := nextV7Time(ts)
+		b := nextV7Time(ts)
+		assert.Equal(t, a+1, b)
+	})
+	t.Run("ClockRollback", func(t *testing.T) {
+		a := nextV7Time(now.Add(3 * time.Hour))
+		b := nextV7Time(now.Add(time.Hour))
+		assert.Equal(t, a+1, b)
+	})
+}
@@ -0,0 +1,9 @@
+// SPDX-FileCopyrightText: Copyright 2023 Prasad Tengse
+// SPDX-License-Identifier: MIT
+
+//go:build msan
+
+package msan
+
+// Enabled reports if the memory sanitizer is enabled.
+const Enabled = true
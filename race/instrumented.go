@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: Copyright 2023 Prasad Tengse
+// SPDX-License-Identifier: MIT
+
+package race
+
+import (
+	"flag"
+	"math"
+	"os"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tprasadtp/pkg/asan"
+	"github.com/tprasadtp/pkg/msan"
+)
+
+// Environment variable which can be used to override the factor
+// used by [ScaleDuration]. It must be a positive number like 2 or 1.5.
+const timeoutScaleEnv = "GO_TEST_TIMEOUT_SCALE"
+
+// Default factor used by [ScaleDuration] when the binary is built
+// with race detector or sanitizers.
+const defaultInstrumentedScale = 5
+
+// Instrumentation describes how the running binary was built and run.
+type Instrumentation struct {
+	// Race reports if the binary was built with -race.
+	Race bool
+	// ASan reports if the binary was built with -asan.
+	ASan bool
+	// MSan reports if the binary was built with -msan.
+	MSan bool
+	// Cover reports if the binary was built with -cover.
+	Cover bool
+	// OptimizationsDisabled reports if the binary was built with
+	// optimizations (-N) or inlining (-l) disabled via -gcflags.
+	OptimizationsDisabled bool
+	// Short reports if tests are running with -short flag.
+	Short bool
+}
+
+// String returns comma separated list of enabled instrumentation,
+// or "none" if none are enabled.
+func (i Instrumentation) String() string {
+	var items []string
+	for _, item := range []struct {
+		enabled bool
+		name    string
+	}{
+		{i.Race, "race"},
+		{i.ASan, "asan"},
+		{i.MSan, "msan"},
+		{i.Cover, "cover"},
+		{i.OptimizationsDisabled, "optimizations-disabled"},
+		{i.Short, "short"},
+	} {
+		if item.enabled {
+			items = append(items, item.name)
+		}
+	}
+	if len(items) == 0 {
+		return "none"
+	}
+	return strings.Join(items, ",")
+}
+
+// Instrumented returns instrumentation of the running binary.
+//
+// Coverage and optimization settings are read from build info
+// embedded in the binary, and are not available if the binary
+// was built without it. Short is only available after flags
+// are parsed by the testing package.
+func Instrumented() Instrumentation {
+	rv := Instrumentation{
+		Race: Enabled,
+		ASan: asan.Enabled,
+		MSan: msan.Enabled,
+	}
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			switch setting.Key {
+			case "-cover":
+				rv.Cover, _ = strconv.ParseBool(setting.Value)
+			case "-gcflags":
+				rv.OptimizationsDisabled = optimizationsDisabled(setting.Value)
+			}
+		}
+	}
+
+	// testing.Short panics if called before flags are registered,
+	// thus lookup the flag directly.
+	if f := flag.Lookup("test.short"); f != nil {
+		rv.Short, _ = strconv.ParseBool(f.Value.String())
+	}
+	return rv
+}
+
+// optimizationsDisabled reports if gcflags disable optimizations or inlining.
+// gcflags may contain package patterns like "all=-N -l".
+func optimizationsDisabled(gcflags string) bool {
+	for _, field := range strings.Fields(gcflags) {
+		if _, after, ok := strings.Cut(field, "="); ok && strings.HasPrefix(after, "-") {
+			field = after
+		}
+		if field == "-N" || field == "-l" {
+			return true
+		}
+	}
+	return false
+}
+
+// ScaleDuration scales d by a factor, so that timeouts do not flake
+// on slow, instrumented builds.
+//
+// Factor can be set with environment variable GO_TEST_TIMEOUT_SCALE.
+// Otherwise, it is 5 when race detector, address sanitizer or memory
+// sanitizer is enabled, and 1 otherwise.
+//
+//	ctx, cancel := context.WithTimeout(ctx, race.ScaleDuration(time.Second))
+func ScaleDuration(d time.Duration) time.Duration {
+	if v, err := strconv.ParseFloat(os.Getenv(timeoutScaleEnv), 64); err == nil && v > 0 && !math.IsInf(v, 1) {
+		return time.Duration(float64(d) * v)
+	}
+	if Enabled || asan.Enabled || msan.Enabled {
+		return d * defaultInstrumentedScale
+	}
+	return d
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: Copyright 2023 Prasad Tengse
+// SPDX-License-Identifier: MIT
+
+package race
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tprasadtp/pkg/asan"
+	"github.com/tprasadtp/pkg/msan"
+)
+
+func TestInstrumented(t *testing.T) {
+	i := Instrumented()
+	if i.Race != Enabled {
+		t.Errorf("Race=%t, expected %t", i.Race, Enabled)
+	}
+	if i.Short != testing.Short() {
+		t.Errorf("Short=%t, expected %t", i.Short, testing.Short())
+	}
+	if i.String() == "" {
+		t.Errorf("String must not be empty")
+	}
+}
+
+func TestInstrumentation_String(t *testing.T) {
+	tt := []struct {
+		name   string
+		i      Instrumentation
+		expect string
+	}{
+		{name: "none", expect: "none"},
+		{name: "race", i: Instrumentation{Race: true}, expect: "race"},
+		{
+			name:   "multiple",
+			i:      Instrumentation{Race: true, Cover: true, OptimizationsDisabled: true, Short: true},
+			expect: "race,cover,optimizations-disabled,short",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.i.String(); got != tc.expect {
+				t.Errorf("expected=%q, got=%q", tc.expect, got)
+			}
+		})
+	}
+}
+
+func Test_optimizationsDisabled(t *testing.T) {
+	tt := []struct {
+		gcflags string
+		expect  bool
+	}{
+		{gcflags: "", expect: false},
+		{gcflags: "-N", expect: true},
+		{gcflags: "all=-N -l", expect: true},
+		{gcflags: "github.com/tprasadtp/pkg/...=-l", expect: true},
+		{gcflags: "-d=checkptr", expect: false},
+		{gcflags: "-m", expect: false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.gcflags, func(t *testing.T) {
+			if got := optimizationsDisabled(tc.gcflags); got != tc.expect {
+				t.Errorf("gcflags=%q, expected=%t, got=%t", tc.gcflags, tc.expect, got)
+			}
+		})
+	}
+}
+
+func TestScaleDuration(t *testing.T) {
+	expect := time.Second
+	if Enabled || asan.Enabled || msan.Enabled {
+		expect = defaultInstrumentedScale * time.Second
+	}
+	t.Run("Default", func(t *testing.T) {
+		t.Setenv(timeoutScaleEnv, "")
+		if got := ScaleDuration(time.Second); got != expect {
+			t.Errorf("expected=%s, got=%s", expect, got)
+		}
+	})
+	t.Run("Env", func(t *testing.T) {
+		t.Setenv(timeoutScaleEnv, "2.5")
+		if got := ScaleDuration(time.Second); got != 2500*time.Millisecond {
+			t.Errorf("expected=2.5s, got=%s", got)
+		}
+	})
+	t.Run("InvalidEnv", func(t *testing.T) {
+		for _, v := range []string{"foo", "-1", "0", "NaN", "Inf"} {
+			t.Setenv(timeoutScaleEnv, v)
+			if got := ScaleDuration(time.Second); got != expect {
+				t.Errorf("%s=%s, expected=%s, got=%s", timeoutScaleEnv, v, expect, got)
+			}
+		}
+	})
+}
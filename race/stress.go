@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: Copyright 2023 Prasad Tengse
+// SPDX-License-Identifier: MIT
+
+package race
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// stressProcs returns GOMAXPROCS values used by [Stress].
+// Running with a single P finds bugs which depend on scheduling order,
+// while running with all CPUs maximizes parallelism.
+func stressProcs() []int {
+	rv := []int{1}
+	for _, n := range []int{2, runtime.NumCPU()} {
+		if n > rv[len(rv)-1] {
+			rv = append(rv, n)
+		}
+	}
+	return rv
+}
+
+// Stress runs f concurrently in the given number of goroutines, for the
+// given number of iterations. In each iteration, all goroutines wait on
+// a start barrier, so that calls to f overlap as much as possible.
+// GOMAXPROCS is varied between iterations and restored once done.
+//
+// Stress stops at the first iteration in which f returns an error or panics,
+// and reports the iteration, goroutine and GOMAXPROCS. It returns true if
+// all iterations completed without errors.
+//
+// Data races are only detected when race detector is enabled, thus Stress
+// logs whether it is active. As GOMAXPROCS is changed, Stress must not be
+// used in parallel tests.
+//
+//	race.Stress(t, 8, 100, func() error {
+//		if v := version.GetInfo(); v.Version == "" {
+//			return errors.New("empty version")
+//		}
+//		return nil
+//	})
+func Stress(t testing.TB, goroutines, iterations int, f func() error) bool {
+	t.Helper()
+	if goroutines < 1 || iterations < 1 {
+		t.Errorf("Stress: goroutines(%d) and iterations(%d) must be positive", goroutines, iterations)
+		return false
+	}
+
+	if Enabled {
+		t.Logf("Stress: race detector is enabled(instrumentation=%s)", Instrumented())
+	} else {
+		t.Logf("Stress: race detector is disabled, data races will not be detected(instrumentation=%s)",
+			Instrumented())
+	}
+
+	procs := stressProcs()
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(0))
+
+	errs := make([]error, goroutines)
+	for i := 0; i < iterations; i++ {
+		n := procs[i%len(procs)]
+		runtime.GOMAXPROCS(n)
+
+		var wg sync.WaitGroup
+		start := make(chan struct{})
+		for g := 0; g < goroutines; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				defer func() {
+					if r := recover(); r != nil {
+						errs[g] = fmt.Errorf("panic: %v", r)
+					}
+				}()
+				<-start
+				errs[g] = f()
+			}(g)
+		}
+		close(start)
+		wg.Wait()
+
+		var failed bool
+		for g, err := range errs {
+			if err != nil {
+				failed = true
+				t.Errorf("Stress: iteration %d of %d(GOMAXPROCS=%d), goroutine %d failed: %s",
+					i+1, iterations, n, g, err)
+			}
+			errs[g] = nil
+		}
+		if failed {
+			return false
+		}
+	}
+	return true
+}
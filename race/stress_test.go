@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: Copyright 2023 Prasad Tengse
+// SPDX-License-Identifier: MIT
+
+package race
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/tprasadtp/pkg/assert/asserttest"
+)
+
+func TestStress(t *testing.T) {
+	procs := runtime.GOMAXPROCS(0)
+	var counter atomic.Int64
+	ok := Stress(t, 4, 10, func() error {
+		counter.Add(1)
+		return nil
+	})
+	if !ok {
+		t.Errorf("Stress must not fail")
+	}
+	if v := counter.Load(); v != 40 {
+		t.Errorf("expected f to be called 40 times, got %d", v)
+	}
+	if v := runtime.GOMAXPROCS(0); v != procs {
+		t.Errorf("GOMAXPROCS must be restored to %d, got %d", procs, v)
+	}
+}
+
+func TestStress_Failure(t *testing.T) {
+	tt := []struct {
+		name   string
+		f      func() error
+		expect string
+	}{
+		{
+			name:   "Error",
+			f:      func() error { return errors.New("test error") },
+			expect: "Stress: iteration 3 of 10(GOMAXPROCS=",
+		},
+		{
+			name:   "Panic",
+			f:      func() error { panic("test panic") },
+			expect: "failed: panic: test panic",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls atomic.Int64
+			r := asserttest.NewRecorder(t)
+			r.Run(func(tb testing.TB) {
+				Stress(tb, 2, 10, func() error {
+					// Fail in the third iteration.
+					if calls.Add(1) > 4 {
+						return tc.f()
+					}
+					return nil
+				})
+			})
+			msgs := r.Messages()
+			if len(msgs) != 2 {
+				t.Fatalf("expected failure from each goroutine, got %q", msgs)
+			}
+			if !strings.Contains(msgs[0], tc.expect) {
+				t.Errorf("expected message to contain %q, got %q", tc.expect, msgs[0])
+			}
+			if v := calls.Load(); v != 6 {
+				t.Errorf("Stress must stop after failing iteration, f called %d times", v)
+			}
+			logs := r.Messages(asserttest.KindLog)
+			if len(logs) != 1 || !strings.Contains(logs[0], "race detector is") {
+				t.Errorf("Stress must log race detector status, got %q", logs)
+			}
+		})
+	}
+}
+
+func TestStress_Invalid(t *testing.T) {
+	r := asserttest.NewRecorder(t)
+	r.Run(func(tb testing.TB) {
+		Stress(tb, 0, 1, func() error { return nil })
+	})
+	if !r.Failed() {
+		t.Errorf("Stress must fail with invalid arguments")
+	}
+}
@@ -2,7 +2,13 @@ package version
 
 import (
 	"encoding/json"
+	"fmt"
+	"runtime"
+	"sync"
 	"testing"
+
+	"github.com/tprasadtp/pkg/assert"
+	"github.com/tprasadtp/pkg/race"
 )
 
 func TestJSON(t *testing.T) {
@@ -63,3 +69,49 @@ func TestGetWithOverride(t *testing.T) {
 // 		t.Errorf("GetInfo().Version s empty when it should be populated automatically")
 // 	}
 // }
+
+// setVersion overrides version for the duration of the test. Package globals
+// populated by GetInfo are restored when test completes.
+func setVersion(t *testing.T, v string) {
+	t.Helper()
+	oldVersion, oldCommit, oldBuildDate := version, commit, buildDate
+	t.Cleanup(func() {
+		version, commit, buildDate = oldVersion, oldCommit, oldBuildDate
+		// sync.Once cannot be copied. Commit and build date are restored
+		// above, thus reading build info again returns the same values.
+		once = sync.Once{}
+	})
+	version = v
+}
+
+func TestGetInfo(t *testing.T) {
+	setVersion(t, "v1.2.3")
+	expect := Info{
+		Version:  "v1.2.3",
+		Os:       runtime.GOOS,
+		Arch:     runtime.GOARCH,
+		Compiler: runtime.Compiler,
+	}
+	assert.Equal(t, expect, GetInfo(),
+		assert.IgnoreFields("Info.Commit", "Info.BuildDate", "Info.GoVersion"))
+}
+
+func TestGetInfo_Stress(t *testing.T) {
+	setVersion(t, "v1.2.3")
+	// Reset once, so that build info is read concurrently.
+	once = sync.Once{}
+
+	var mu sync.Mutex
+	seen := make(map[Info]int)
+	race.Stress(t, 8, 50, func() error {
+		info := GetInfo()
+		if info.Version != "v1.2.3" {
+			return fmt.Errorf("expected version v1.2.3, got %q", info.Version)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		seen[info]++
+		return nil
+	})
+	assert.Len(t, seen, 1, "GetInfo must return consistent results: %v", seen)
+}